@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
 )
 
 // NodeInfo detailed information from a node
@@ -147,21 +148,26 @@ func toNodeInfo(nodeObject *gomaasapi.MAASObject) (*NodeInfo, error) { // nolint
 
 // Config provider configuration
 type Config struct {
-	APIKey     string
-	APIURL     string
-	APIver     string
-	MAASObject *gomaasapi.MAASObject
+	APIKey       string
+	APIURL       string
+	APIver       string
+	FailoverURLs []string
+	MAASObject   *gomaasapi.MAASObject
+}
+
+// MAAS returns the MAAS API client, which the resources of the internal provider package use.
+func (c *Config) MAAS() *gomaasapi.MAASObject {
+	return c.MAASObject
 }
 
 // Client authenticate to MAAS and create a session
 func (c *Config) Client() (interface{}, error) {
 	log.Println("[DEBUG] [Config.Client] Configuring the MAAS API client")
-	authClient, err := gomaasapi.NewAuthenticatedClient(
-		gomaasapi.AddAPIVersionToURL(c.APIURL, c.APIver), c.APIKey)
+	mo, err := gmaw.GetClient(c.APIURL, c.APIKey, c.APIver, c.FailoverURLs...)
 	if err != nil {
 		log.Printf("[ERROR] [Config.Client] Unable to authenticate against the MAAS Server (%s)", c.APIURL)
 		return nil, err
 	}
-	c.MAASObject = gomaasapi.NewMAAS(*authClient)
+	c.MAASObject = mo
 	return c, nil
 }
@@ -21,5 +21,6 @@ require (
 	github.com/juju/version v0.0.0-20161031051906-1f41e27e54f2 // indirect
 	github.com/mitchellh/gox v1.0.1 // indirect
 	github.com/mitchellh/reflectwalk v1.0.1 // indirect
+	golang.org/x/crypto v0.0.0-20190701094942-4def268fd1a4
 	gopkg.in/mgo.v2 v2.0.0-20160818020120-3f83fa500528 // indirect
 )
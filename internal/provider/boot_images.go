@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// bootLoaders are the boot resources that are not operating systems, and cannot be deployed
+var bootLoaders = map[string]bool{
+	"grub-efi":        true,
+	"grub-efi-signed": true,
+	"grub-ieee1275":   true,
+	"pxelinux":        true,
+}
+
+// BootImage is a deployable boot resource, split into the parts that are given to deploy a machine.
+// The boot resource name is "<os>/<release>" (custom images have no OS), and its architecture is
+// "<arch>/<subarch>", where the subarch is the kernel of the image (eg ga-18.04 or hwe-18.04).
+type BootImage struct {
+	Resource     *entity.BootResource
+	OS           string
+	Release      string
+	Architecture string
+	Subarch      string
+	Subarches    []string
+}
+
+// NewBootImages returns the boot images of the deployable boot resources, leaving out the boot loaders.
+func NewBootImages(resources []entity.BootResource) []BootImage {
+	images := make([]BootImage, 0, len(resources))
+	for idx := range resources {
+		res := &resources[idx]
+		img := BootImage{Resource: res, OS: "custom", Release: res.Name}
+		if i := strings.Index(res.Name, "/"); i >= 0 {
+			img.OS, img.Release = res.Name[:i], res.Name[i+1:]
+		}
+		if bootLoaders[img.OS] {
+			continue
+		}
+		img.Architecture = res.Architecture
+		if i := strings.Index(res.Architecture, "/"); i >= 0 {
+			img.Architecture, img.Subarch = res.Architecture[:i], res.Architecture[i+1:]
+		}
+		if res.Subarches != "" {
+			img.Subarches = strings.Split(res.Subarches, ",")
+		}
+		images = append(images, img)
+	}
+	return images
+}
+
+// IsRelease returns whether the image is of <series>, given either as a release (eg bionic)
+// or as an OS and a release (eg ubuntu/bionic).
+func (img *BootImage) IsRelease(series string) bool {
+	return series == img.Release || series == img.OS+"/"+img.Release
+}
+
+// HasKernel returns whether <kernel> can be used with the image, ie it is the kernel of the image
+// or one of the kernels the image supports.
+func (img *BootImage) HasKernel(kernel string) bool {
+	if kernel == img.Subarch {
+		return true
+	}
+	for _, subarch := range img.Subarches {
+		if kernel == subarch {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateBootImage returns an error if <series> has not been imported, or if <kernel> is not
+// available for it. An empty series matches any image, and an empty kernel is not checked.
+func ValidateBootImage(images []BootImage, series, kernel string) error {
+	var matches []BootImage
+	releases := map[string]bool{}
+	for _, img := range images {
+		releases[img.OS+"/"+img.Release] = true
+		if series == "" || img.IsRelease(series) {
+			matches = append(matches, img)
+		}
+	}
+	if len(matches) == 0 {
+		return fmt.Errorf("distro_series '%s' has not been imported (available: %s)",
+			series, strings.Join(bootImageKeys(releases), ", "))
+	}
+	if kernel == "" {
+		return nil
+	}
+
+	kernels := map[string]bool{}
+	for _, img := range matches {
+		if img.HasKernel(kernel) {
+			return nil
+		}
+		kernels[img.Subarch] = true
+	}
+	return fmt.Errorf("hwe_kernel '%s' is not available for distro_series '%s' (available: %s)",
+		kernel, series, strings.Join(bootImageKeys(kernels), ", "))
+}
+
+// bootImageKeys returns the keys of <m> sorted, for use in error messages
+func bootImageKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,69 @@
+package provider_test
+
+import (
+	"testing"
+
+	. "github.com/roblox/terraform-provider-maas/internal/provider"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewBootImages(t *testing.T) {
+	var resources []entity.BootResource
+	if err := helper.TestdataFromJSON("maas/boot_resources.json", &resources); err != nil {
+		t.Fatal(err)
+	}
+
+	images := NewBootImages(resources)
+	if len(images) != 4 {
+		t.Fatalf("len(images) = %d, want 4 (the boot loader is left out)", len(images))
+	}
+	img := images[1]
+	if img.OS != "ubuntu" || img.Release != "bionic" || img.Architecture != "amd64" || img.Subarch != "hwe-18.04" {
+		t.Errorf("image = %s/%s %s/%s, want ubuntu/bionic amd64/hwe-18.04",
+			img.OS, img.Release, img.Architecture, img.Subarch)
+	}
+	if img := images[3]; img.OS != "custom" || img.Release != "centos7-hardened" {
+		t.Errorf("image = %s/%s, want custom/centos7-hardened", img.OS, img.Release)
+	}
+}
+
+func TestValidateBootImage(t *testing.T) {
+	var resources []entity.BootResource
+	if err := helper.TestdataFromJSON("maas/boot_resources.json", &resources); err != nil {
+		t.Fatal(err)
+	}
+	images := NewBootImages(resources)
+
+	tests := []struct {
+		series  string
+		kernel  string
+		wantErr string
+	}{
+		{series: "bionic"},
+		{series: "ubuntu/focal"},
+		{series: "centos7-hardened"},
+		{kernel: "hwe-18.04"},
+		{series: "bionic", kernel: "hwe-18.04"},
+		{series: "bionic", kernel: "hwe-p"},
+		{series: "xenial",
+			wantErr: "distro_series 'xenial' has not been imported " +
+				"(available: custom/centos7-hardened, ubuntu/bionic, ubuntu/focal)"},
+		{series: "bionic", kernel: "hwe-20.04",
+			wantErr: "hwe_kernel 'hwe-20.04' is not available for distro_series 'bionic' " +
+				"(available: ga-18.04, hwe-18.04)"},
+	}
+
+	for _, testCase := range tests {
+		tc := testCase
+		t.Run(tc.series+" "+tc.kernel, func(t *testing.T) {
+			err := ValidateBootImage(images, tc.series, tc.kernel)
+			switch {
+			case tc.wantErr == "" && err != nil:
+				t.Fatalf("ValidateBootImage() = %s, want no error", err)
+			case tc.wantErr != "" && (err == nil || err.Error() != tc.wantErr):
+				t.Fatalf("ValidateBootImage() = %v, want %s", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+)
+
+// DataBootImages provides a lookup for the boot images that have been imported into MaaS,
+// with one image per release, architecture and kernel
+func DataBootImages() *schema.Resource {
+	return &schema.Resource{
+		Read: dataBootImagesRead,
+
+		Schema: map[string]*schema.Schema{
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "synced",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					switch v := val.(string); v {
+					case "synced", "uploaded", "generated":
+					default:
+						errs = append(errs, fmt.Errorf("%q must be 'synced', 'uploaded' or 'generated' (got '%s')", key, v))
+					}
+					return
+				},
+			},
+			"os": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"release": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"architecture": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"images": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"os": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"release": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"architecture": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subarch": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subarches": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataBootImagesRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	resources, err := gmaw.NewBootResources(mo).Get(&params.BootResourcesRead{Type: d.Get("type").(string)})
+	if err != nil {
+		return err
+	}
+
+	osName, release, arch := d.Get("os").(string), d.Get("release").(string), d.Get("architecture").(string)
+	images := make([]map[string]interface{}, 0, len(resources))
+	for _, img := range NewBootImages(resources) {
+		if (osName != "" && osName != img.OS) || (release != "" && release != img.Release) ||
+			(arch != "" && arch != img.Architecture) {
+			continue
+		}
+		images = append(images, map[string]interface{}{
+			"id":           img.Resource.ID,
+			"os":           img.OS,
+			"release":      img.Release,
+			"title":        img.Resource.Title,
+			"architecture": img.Architecture,
+			"subarch":      img.Subarch,
+			"subarches":    img.Subarches,
+		})
+	}
+	if err := d.Set("images", images); err != nil {
+		return err
+	}
+	d.SetId(strings.Join([]string{d.Get("type").(string), osName, release, arch}, "/"))
+	return nil
+}
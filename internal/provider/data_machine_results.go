@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// DataMachineResults provides the commissioning, testing, and installation results of a MaaS Machine
+func DataMachineResults() *schema.Resource {
+	return &schema.Resource{
+		Read: dataMachineResultsRead,
+
+		Schema: map[string]*schema.Schema{
+			"system_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					if !(v == "commissioning" || v == "testing" || v == "installation") {
+						errs = append(errs, fmt.Errorf("%q must be 'commissioning', 'testing', or 'installation' (got '%s')",
+							key, v))
+					}
+					return
+				},
+			},
+			"hardware_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filters": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"include_output": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"results": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"started": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ended": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"runtime": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scripts": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status_name": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"exit_status": &schema.Schema{
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"runtime": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"stdout": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"stderr": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataMachineResultsRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	systemID := d.Get("system_id").(string)
+	criteria := &params.NodeResultSearch{
+		Type:          d.Get("type").(string),
+		HardwareType:  d.Get("hardware_type").(string),
+		IncludeOutput: d.Get("include_output").(bool),
+	}
+	for _, filter := range d.Get("filters").([]interface{}) {
+		criteria.Filters = append(criteria.Filters, filter.(string))
+	}
+	res, err := gmaw.NewNodeResults(mo).Get(systemID, criteria)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("results", dataMachineResultsFlatten(res)); err != nil {
+		return err
+	}
+	d.SetId(systemID)
+	return nil
+}
+
+// dataMachineResultsFlatten converts the result sets into the "results" attribute.
+func dataMachineResultsFlatten(res []entity.NodeResult) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(res))
+	for idx := range res {
+		scripts := make([]map[string]interface{}, 0, len(res[idx].Results))
+		for _, script := range res[idx].Results {
+			scripts = append(scripts, map[string]interface{}{
+				"name":        script.Name,
+				"status_name": script.StatusName,
+				"exit_status": script.ExitStatus,
+				"runtime":     script.Runtime,
+				"stdout":      string(script.Stdout),
+				"stderr":      string(script.Stderr),
+			})
+		}
+		results = append(results, map[string]interface{}{
+			"id":          res[idx].ID,
+			"type_name":   res[idx].TypeName,
+			"status_name": res[idx].StatusName,
+			"started":     res[idx].Started,
+			"ended":       res[idx].Ended,
+			"runtime":     res[idx].Runtime,
+			"scripts":     scripts,
+		})
+	}
+	return results
+}
@@ -4,12 +4,12 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/juju/gomaasapi"
 	"github.com/roblox/terraform-provider-maas/pkg/api/params"
 	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
 )
 
-// DataRackController provides a lookup for MaaS Rack Controllers
+// DataRackController provides a lookup for a single MaaS Rack Controller, along with
+// its interfaces, the status of its services and the boot images it serves
 func DataRackController() *schema.Resource {
 	return &schema.Resource{
 		Read: dataRackControllerRead,
@@ -18,6 +18,7 @@ func DataRackController() *schema.Resource {
 			"hostname": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"mac_address": &schema.Schema{
 				Type:     schema.TypeString,
@@ -26,29 +27,86 @@ func DataRackController() *schema.Resource {
 			"system_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"domain": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"zone": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"pool": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"agent_name": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"fqdn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_addresses": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"interfaces": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     rackControllerInterfaceSchema(),
+			},
+			"services": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"boot_images_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"boot_images": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"architecture": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subarches": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"power_types": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
 
 func dataRackControllerRead(d *schema.ResourceData, m interface{}) error {
-	mo := m.(*gomaasapi.MAASObject)
+	mo := maasClient(m)
 	criteria := &params.RackControllerSearch{
 		Hostname:   d.Get("hostname").(string),
 		MACAddress: d.Get("mac_address").(string),
@@ -62,25 +120,57 @@ func dataRackControllerRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	if len(ctrls) == 0 {
+	switch len(ctrls) {
+	case 0:
 		return fmt.Errorf("no matching rack controllers found")
+	case 1:
+	default:
+		return fmt.Errorf("%d rack controllers match, narrow down the search", len(ctrls))
 	}
+	ctrl := &ctrls[0]
 
-	if err := d.Set("hostname", ctrls[0].Hostname); err != nil {
+	images, err := gmaw.NewRackController(mo).ListBootImages(ctrl.SystemID)
+	if err != nil {
 		return err
 	}
-	if err := d.Set("system_id", ctrls[0].SystemID); err != nil {
-		return err
+	bootImages := make([]map[string]interface{}, 0, len(images.Images))
+	for _, img := range images.Images {
+		bootImages = append(bootImages, map[string]interface{}{
+			"name":         img.Name,
+			"architecture": img.Architecture,
+			"subarches":    img.Subarches,
+		})
 	}
-	if err := d.Set("domain", ctrls[0].Domain.Name); err != nil {
+
+	powerTypes, err := gmaw.NewRackControllers(mo).DescribePowerTypes()
+	if err != nil {
 		return err
 	}
-	if err := d.Set("zone", ctrls[0].Zone.Name); err != nil {
-		return err
+	powerTypeNames := make([]string, 0, len(powerTypes))
+	for _, powerType := range powerTypes {
+		powerTypeNames = append(powerTypeNames, powerType.Name)
 	}
-	if err := d.Set("pool", ctrls[0].Pool.Name); err != nil {
-		return err
+
+	tfstate := map[string]interface{}{
+		"hostname":           ctrl.Hostname,
+		"system_id":          ctrl.SystemID,
+		"domain":             ctrl.Domain.Name,
+		"zone":               ctrl.Zone.Name,
+		"pool":               ctrl.Pool.Name,
+		"fqdn":               ctrl.FQDN,
+		"version":            ctrl.Version,
+		"ip_addresses":       rackControllerIPAddresses(ctrl),
+		"interfaces":         rackControllerInterfaces(ctrl.InterfaceSet),
+		"services":           rackControllerServices(ctrl.ServiceSet),
+		"boot_images_status": images.Status,
+		"boot_images":        bootImages,
+		"power_types":        powerTypeNames,
+	}
+	for k, v := range tfstate {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
 	}
-	d.SetId(ctrls[0].SystemID)
+	d.SetId(ctrl.SystemID)
 	return nil
 }
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+)
+
+// DataRackControllers provides a lookup for all of the MaaS Rack Controllers that match,
+// along with their interfaces, the VLANs they serve and the health of their services
+func DataRackControllers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataRackControllersRead,
+
+		Schema: map[string]*schema.Schema{
+			"hostname": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"mac_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"pool": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"require_healthy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"controllers": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"system_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hostname": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fqdn": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_addresses": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"interfaces": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     rackControllerInterfaceSchema(),
+						},
+						"vlans": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": &schema.Schema{
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"vid": &schema.Schema{
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"fabric": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"dhcp_on": &schema.Schema{
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"primary": &schema.Schema{
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"services": &schema.Schema{
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"unhealthy_services": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"healthy": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataRackControllersRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	criteria := &params.RackControllerSearch{
+		Hostname:   d.Get("hostname").(string),
+		MACAddress: d.Get("mac_address").(string),
+		Domain:     d.Get("domain").(string),
+		Zone:       d.Get("zone").(string),
+		Pool:       d.Get("pool").(string),
+	}
+	ctrls, err := gmaw.NewRackControllers(mo).Get(criteria)
+	if err != nil {
+		return err
+	}
+	sort.Slice(ctrls, func(i, j int) bool { return ctrls[i].Hostname < ctrls[j].Hostname })
+
+	var unhealthy []string
+	controllers := make([]map[string]interface{}, 0, len(ctrls))
+	for idx := range ctrls {
+		ctrl := &ctrls[idx]
+		vlans := []map[string]interface{}{}
+		for _, vlan := range rackControllerVLANs(ctrl) {
+			vlans = append(vlans, map[string]interface{}{
+				"id":      vlan.ID,
+				"vid":     vlan.VID,
+				"fabric":  vlan.Fabric,
+				"dhcp_on": vlan.DHCPOn,
+				"primary": vlan.PrimaryRack == ctrl.SystemID,
+			})
+		}
+		services := RackControllerUnhealthyServices(ctrl)
+		if len(services) > 0 {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s (%s)", ctrl.Hostname, strings.Join(services, ", ")))
+		}
+		controllers = append(controllers, map[string]interface{}{
+			"system_id":          ctrl.SystemID,
+			"hostname":           ctrl.Hostname,
+			"fqdn":               ctrl.FQDN,
+			"version":            ctrl.Version,
+			"ip_addresses":       rackControllerIPAddresses(ctrl),
+			"interfaces":         rackControllerInterfaces(ctrl.InterfaceSet),
+			"vlans":              vlans,
+			"services":           rackControllerServices(ctrl.ServiceSet),
+			"unhealthy_services": services,
+			"healthy":            len(services) == 0,
+		})
+	}
+	if d.Get("require_healthy").(bool) && len(unhealthy) > 0 {
+		return fmt.Errorf("rack controllers are not healthy: %s", strings.Join(unhealthy, "; "))
+	}
+
+	if err := d.Set("controllers", controllers); err != nil {
+		return err
+	}
+	d.SetId(strings.Join([]string{
+		criteria.Hostname, criteria.MACAddress, criteria.Domain, criteria.Zone, criteria.Pool,
+	}, "/"))
+	return nil
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// DataRegionControllers provides a lookup for all of the MaaS Region Controllers that match,
+// along with their interfaces and the status of their services
+func DataRegionControllers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataRegionControllersRead,
+
+		Schema: map[string]*schema.Schema{
+			"hostname": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"pool": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"controllers": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"system_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hostname": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fqdn": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_addresses": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"interfaces": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     rackControllerInterfaceSchema(),
+						},
+						"services": &schema.Schema{
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataRegionControllersRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	criteria := &params.RegionControllerSearch{
+		Hostname: d.Get("hostname").(string),
+		Domain:   d.Get("domain").(string),
+		Zone:     d.Get("zone").(string),
+		Pool:     d.Get("pool").(string),
+	}
+	ctrls, err := gmaw.NewRegionControllers(mo).Get(criteria)
+	if err != nil {
+		return err
+	}
+	sort.Slice(ctrls, func(i, j int) bool { return ctrls[i].Hostname < ctrls[j].Hostname })
+
+	controllers := make([]map[string]interface{}, 0, len(ctrls))
+	for idx := range ctrls {
+		// Region and rack controllers share the same representation in MaaS
+		ctrl := (*entity.RackController)(&ctrls[idx])
+		controllers = append(controllers, map[string]interface{}{
+			"system_id":    ctrl.SystemID,
+			"hostname":     ctrl.Hostname,
+			"fqdn":         ctrl.FQDN,
+			"version":      ctrl.Version,
+			"ip_addresses": rackControllerIPAddresses(ctrl),
+			"interfaces":   rackControllerInterfaces(ctrl.InterfaceSet),
+			"services":     rackControllerServices(ctrl.ServiceSet),
+		})
+	}
+
+	if err := d.Set("controllers", controllers); err != nil {
+		return err
+	}
+	d.SetId(strings.Join([]string{criteria.Hostname, criteria.Domain, criteria.Zone, criteria.Pool}, "/"))
+	return nil
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+)
+
+// DataResourcePool provides a lookup for a MaaS Resource Pool
+func DataResourcePool() *schema.Resource {
+	return &schema.Resource{
+		Read: dataResourcePoolRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataResourcePoolRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	res, err := gmaw.NewResourcePools(mo).Get()
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	for idx := range res {
+		if res[idx].Name != name {
+			continue
+		}
+		if err := d.Set("description", res[idx].Description); err != nil {
+			return err
+		}
+		d.SetId(strconv.Itoa(res[idx].ID))
+		return nil
+	}
+	return fmt.Errorf("could not find resource pool %s", name)
+}
@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/juju/gomaasapi"
 	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
 	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
 )
@@ -48,7 +47,7 @@ func DataSubnet() *schema.Resource {
 }
 
 func dataSubnetRead(d *schema.ResourceData, m interface{}) error {
-	mo := m.(*gomaasapi.MAASObject)
+	mo := maasClient(m)
 	res, err := gmaw.NewSubnets(mo).Get()
 	if err != nil {
 		return err
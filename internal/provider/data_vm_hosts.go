@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// DataVMHosts provides a lookup for the MaaS Pods that can take a VM of a given shape,
+// sorted by how well the VM fits
+func DataVMHosts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataVMHostsRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"pool": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tag": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"cores": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"memory": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"storage": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"hosts": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pool": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"cores_total": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"cores_available": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory_total": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory_available": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"storage_total": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"storage_available": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"fits": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataVMHostsRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	pods, err := gmaw.NewPods(mo).Get()
+	if err != nil {
+		return err
+	}
+
+	caps := make([]*VMHostCapacity, 0, len(pods))
+	for idx := range pods {
+		if dataVMHostsIsMatch(d, &pods[idx]) {
+			caps = append(caps, NewVMHostCapacity(&pods[idx]))
+		}
+	}
+	SortVMHostCapacities(caps, d.Get("cores").(int), d.Get("memory").(int), d.Get("storage").(int))
+
+	hosts := make([]map[string]interface{}, 0, len(caps))
+	for _, c := range caps {
+		fits, _ := c.Fit(d.Get("cores").(int), d.Get("memory").(int), d.Get("storage").(int))
+		hosts = append(hosts, map[string]interface{}{
+			"id":                c.Pod.ID,
+			"name":              c.Pod.Name,
+			"zone":              c.Pod.Zone.Name,
+			"pool":              c.Pod.Pool.Name,
+			"tags":              c.Pod.Tags,
+			"cores_total":       c.CoresTotal,
+			"cores_available":   c.CoresAvailable,
+			"memory_total":      c.MemoryTotal,
+			"memory_available":  c.MemoryAvailable,
+			"storage_total":     c.StorageTotal,
+			"storage_available": c.StorageAvailable,
+			"fits":              fits,
+		})
+	}
+	if err := d.Set("hosts", hosts); err != nil {
+		return err
+	}
+	d.SetId(strings.Join([]string{d.Get("zone").(string), d.Get("pool").(string), d.Get("tag").(string)}, "/"))
+	return nil
+}
+
+func dataVMHostsIsMatch(d *schema.ResourceData, pod *entity.Pod) bool {
+	zone, pool, tag := d.Get("zone").(string), d.Get("pool").(string), d.Get("tag").(string)
+	if !(zone == "" || zone == pod.Zone.Name) {
+		return false
+	}
+	if !(pool == "" || pool == pod.Pool.Name) {
+		return false
+	}
+	if tag == "" {
+		return true
+	}
+	for _, t := range pod.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// deployOptionVersions are the MaaS versions that added the deploy options of maas_instance
+// that older versions reject or ignore.
+var deployOptionVersions = map[string]string{
+	"ephemeral_deploy": "3.2",
+	"enable_hw_sync":   "3.2",
+}
+
+// ValidateDeployOptions returns an error if any of the deploy <options> is not supported by
+// <version>, the version reported by the MaaS server (eg "3.2.6" or "3.3.0~beta2").
+func ValidateDeployOptions(version string, options ...string) error {
+	var unsupported []string
+	for _, option := range options {
+		minVersion, ok := deployOptionVersions[option]
+		if !ok {
+			continue
+		}
+		cmp, err := compareMAASVersions(version, minVersion)
+		if err != nil {
+			return err
+		}
+		if cmp < 0 {
+			unsupported = append(unsupported, fmt.Sprintf("%s needs MaaS %s or later", option, minVersion))
+		}
+	}
+	if len(unsupported) == 0 {
+		return nil
+	}
+	sort.Strings(unsupported)
+	return fmt.Errorf("MaaS %s does not support the deploy options: %s", version, strings.Join(unsupported, ", "))
+}
+
+// compareMAASVersions compares the release numbers of two MaaS versions, ignoring any pre-release
+// suffix, and returns -1, 0 or 1 if <a> is lower than, equal to or greater than <b>.
+// Missing release numbers are zero, so "3.2" equals "3.2.0".
+func compareMAASVersions(a, b string) (int, error) {
+	va, err := parseMAASVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := parseMAASVersion(b)
+	if err != nil {
+		return 0, err
+	}
+	for len(va) < len(vb) {
+		va = append(va, 0)
+	}
+	for len(vb) < len(va) {
+		vb = append(vb, 0)
+	}
+	for idx := range va {
+		switch {
+		case va[idx] < vb[idx]:
+			return -1, nil
+		case va[idx] > vb[idx]:
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+// parseMAASVersion returns the release numbers of a MaaS version.
+func parseMAASVersion(version string) ([]int, error) {
+	release := version
+	if idx := strings.IndexAny(release, "~-+ "); idx >= 0 {
+		release = release[:idx]
+	}
+	parts := strings.Split(release, ".")
+	res := make([]int, 0, len(parts))
+	for _, part := range parts {
+		v, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MaaS version '%s'", version)
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
@@ -0,0 +1,36 @@
+package provider_test
+
+import (
+	"testing"
+
+	. "github.com/roblox/terraform-provider-maas/internal/provider"
+)
+
+func TestValidateDeployOptions(t *testing.T) {
+	tests := []struct {
+		version string
+		options []string
+		valid   bool
+	}{
+		{"3.2.6", []string{"ephemeral_deploy", "enable_hw_sync"}, true},
+		{"3.2", []string{"ephemeral_deploy"}, true},
+		{"3.3.0~beta2", []string{"enable_hw_sync"}, true},
+		{"10.0.0", []string{"ephemeral_deploy"}, true},
+		{"3.1.1", []string{"ephemeral_deploy"}, false},
+		{"2.9.2", []string{"enable_hw_sync"}, false},
+		{"3.2.0~rc1", []string{"enable_hw_sync"}, true},
+		{"2.9.2", nil, true},
+		{"2.9.2", []string{"kernel_options"}, true},
+		{"", []string{"ephemeral_deploy"}, false},
+		{"three", []string{"ephemeral_deploy"}, false},
+	}
+	for _, tc := range tests {
+		err := ValidateDeployOptions(tc.version, tc.options...)
+		if tc.valid && err != nil {
+			t.Errorf("ValidateDeployOptions(%q, %q) = %s, want no error", tc.version, tc.options, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("ValidateDeployOptions(%q, %q) returned no error", tc.version, tc.options)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package provider
+
+import "strings"
+
+// SplitFQDN returns the name and domain of a DNS record from its FQDN, using the longest of
+// <domains> the FQDN ends with, since both the name (eg _sip._tcp) and the domain may contain
+// dots. The FQDN is split at its first dot when it does not end with any of <domains>.
+func SplitFQDN(fqdn string, domains []string) (name, domain string) {
+	for _, d := range domains {
+		if len(d) > len(domain) && strings.HasSuffix(fqdn, "."+d) {
+			domain = d
+		}
+	}
+	if domain != "" {
+		return strings.TrimSuffix(fqdn, "."+domain), domain
+	}
+	if idx := strings.Index(fqdn, "."); idx > 0 {
+		return fqdn[:idx], fqdn[idx+1:]
+	}
+	return fqdn, ""
+}
@@ -0,0 +1,31 @@
+package provider_test
+
+import (
+	"testing"
+
+	. "github.com/roblox/terraform-provider-maas/internal/provider"
+)
+
+func TestSplitFQDN(t *testing.T) {
+	tests := []struct {
+		fqdn    string
+		domains []string
+		name    string
+		domain  string
+	}{
+		{"www.maas", []string{"maas"}, "www", "maas"},
+		{"_sip._tcp.maas", []string{"maas"}, "_sip._tcp", "maas"},
+		{"www.example.com", []string{"com", "example.com"}, "www", "example.com"},
+		{"_sip._tcp.example.com", []string{"example.com", "maas"}, "_sip._tcp", "example.com"},
+		{"www.notmaas", []string{"maas"}, "www", "notmaas"},
+		{"www.maas", nil, "www", "maas"},
+		{"maas", nil, "maas", ""},
+	}
+	for _, tc := range tests {
+		name, domain := SplitFQDN(tc.fqdn, tc.domains)
+		if name != tc.name || domain != tc.domain {
+			t.Errorf("SplitFQDN(%q, %q) = (%q, %q), want (%q, %q)", tc.fqdn, tc.domains, name, domain,
+				tc.name, tc.domain)
+		}
+	}
+}
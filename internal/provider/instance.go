@@ -2,6 +2,7 @@ package provider
 
 import (
 	"crypto/sha1" // nolint: gosec
+	"encoding/base64"
 	"encoding/hex"
 	"reflect"
 	"strings"
@@ -26,17 +27,19 @@ type Instance struct {
 	Zone                   []Zone        `optional:"true" type:"Set"`
 	Architecture           string        `optional:"true" forcenew:"true"`
 	BootType               string        `optional:"true" forcenew:"true"`
-	DistroSeries           string        `optional:"true" forcenew:"true"`
+	DistroSeries           string        `optional:"true" forcenew:"true" name:"distro_series"`
 	Hostname               string        `optional:"true" forcenew:"true"`
 	DeployHostname         string        `optional:"true" forcenew:"true"`
 	OSystem                string        `optional:"true" forcenew:"true"`
+	LicenseKey             string        `optional:"true" forcenew:"true" sensitive:"true" name:"license_key"`
 	Owner                  string        `optional:"true" forcenew:"true"`
 	PowerState             string        `optional:"true"`
 	PowerType              string        `optional:"true"`
 	ResourceURI            string        `optional:"true" forcenew:"true"`
 	SystemID               string        `optional:"true" forcenew:"true"`
-	UserData               string        `optional:"true" forcenew:"true" statefunc:"true"`
-	HWEKernel              string        `optional:"true" forcenew:"true"`
+	UserData               string        `optional:"true" forcenew:"true" statefunc:"true" name:"user_data"`
+	HWEKernel              string        `optional:"true" forcenew:"true" name:"hwe_kernel"`
+	KernelOptions          string        `optional:"true" forcenew:"true" name:"kernel_options"`
 	Comment                string        `optional:"true"`
 	CPUCount               int           `optional:"true" forcenew:"true"`
 	Memory                 int           `optional:"true" forcenew:"true"`
@@ -48,7 +51,10 @@ type Instance struct {
 	ReleaseEraseSecure     bool          `optional:"true" forcenew:"true" default:"false"`
 	ReleaseEraseQuick      bool          `optional:"true" forcenew:"true" default:"false"`
 	Netboot                bool          `optional:"true" forcenew:"true"`
-	InstallKVM             bool          `optional:"true" forcenew:"true" default:"false"`
+	InstallKVM             bool          `optional:"true" forcenew:"true" default:"false" name:"install_kvm"`
+	InstallRackD           bool          `optional:"true" forcenew:"true" default:"false" name:"install_rackd"`
+	EphemeralDeploy        bool          `optional:"true" forcenew:"true" default:"false" name:"ephemeral_deploy"`
+	EnableHWSync           bool          `optional:"true" forcenew:"true" default:"false" name:"enable_hw_sync"`
 	Lock                   bool          `optional:"true" default:"false"`
 }
 
@@ -56,7 +62,7 @@ type Instance struct {
 func NewInstance(resource *schema.ResourceData) *Instance {
 	var instance Instance
 	st := reflect.TypeOf(instance)
-	sv := reflect.ValueOf(instance)
+	sv := reflect.ValueOf(&instance).Elem()
 
 	for i := 0; i < st.NumField(); i++ {
 		// Get the name of the schema field
@@ -69,11 +75,19 @@ func NewInstance(resource *schema.ResourceData) *Instance {
 		}
 		key = strings.ToLower(key)
 
-		// Set the value if one exists
-		if schemaVal, ok := resource.GetOk(key); ok {
-			field := sv.FieldByName(key)
-			reflectVal := reflect.ValueOf(schemaVal)
-			field.Set(reflectVal)
+		// Set the value if one exists. Lists of strings are the only lists that are read.
+		schemaVal, ok := resource.GetOk(key)
+		if !ok {
+			continue
+		}
+		if list, ok := schemaVal.([]interface{}); ok {
+			if sv.Field(i).Type() != reflect.TypeOf([]string(nil)) {
+				continue
+			}
+			schemaVal = listToStrings(list)
+		}
+		if reflectVal := reflect.ValueOf(schemaVal); reflectVal.Type().AssignableTo(sv.Field(i).Type()) {
+			sv.Field(i).Set(reflectVal)
 		}
 	}
 	return &instance
@@ -91,8 +105,8 @@ func (i *Instance) GetMetadata() interface{} {
 
 // UpdateState updates the Terraform state to match the Instance state
 func (i *Instance) UpdateState(resource *schema.ResourceData) {
-	st := reflect.TypeOf(i)
-	sv := reflect.ValueOf(i)
+	st := reflect.TypeOf(*i)
+	sv := reflect.ValueOf(*i)
 
 	for i := 0; i < st.NumField(); i++ {
 		// Get the name of the schema field
@@ -113,19 +127,33 @@ func (i *Instance) UpdateState(resource *schema.ResourceData) {
 	}
 }
 
-// AllocateParams creates parameters based on the current value of the Instance
+// AllocateParams creates parameters based on the current value of the Instance.
+// Any available machine is allocated when the Instance has no SystemID.
 func (i *Instance) AllocateParams() *maas.MachinesAllocateParams {
 	var params maas.MachinesAllocateParams
-	if i.SystemID != "" {
-		params.SystemID = i.SystemID
-		return &params
-	}
-	return nil
+	params.SystemID = i.SystemID
+	return &params
 }
 
-// DeployParams creates parameters based on the current value of the Instance
+// DeployParams creates parameters based on the current value of the Instance.
+// The OSystem and DistroSeries select the image to deploy, so a custom image is
+// deployed with the "custom" OSystem and the name of the image as the DistroSeries.
 func (i *Instance) DeployParams() *maas.MachineDeployParams {
-	var params maas.MachineDeployParams
+	params := maas.MachineDeployParams{
+		OSystem:         i.OSystem,
+		DistroSeries:    i.DistroSeries,
+		HWEKernel:       i.HWEKernel,
+		LicenseKey:      i.LicenseKey,
+		KernelOptions:   i.KernelOptions,
+		Comment:         i.Comment,
+		InstallKVM:      i.InstallKVM,
+		InstallRackD:    i.InstallRackD,
+		EphemeralDeploy: i.EphemeralDeploy,
+		EnableHWSync:    i.EnableHWSync,
+	}
+	if i.UserData != "" {
+		params.UserData = base64.StdEncoding.EncodeToString([]byte(i.UserData))
+	}
 	return &params
 }
 
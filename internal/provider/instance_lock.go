@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/maas"
+)
+
+// The lock of an instance is tracked by three attributes: lock is whether Terraform should
+// keep the machine locked, locked is whether the machine is locked, and locked_by_terraform
+// is whether Terraform placed the lock. A lock placed outside of Terraform is never removed.
+
+// ReadInstanceLock sets the locked and locked_by_terraform attributes from the machine.
+// A lock that Terraform placed does not survive the machine being unlocked elsewhere.
+func ReadInstanceLock(d *schema.ResourceData, m *maas.Machine) error {
+	if err := d.Set("locked", m.Locked); err != nil {
+		return err
+	}
+	if !m.Locked {
+		return d.Set("locked_by_terraform", false)
+	}
+	return nil
+}
+
+// InstanceLockCustomizeDiff plans a change of the locked attribute when the machine has to be
+// locked or unlocked to match the lock attribute, so that the update reconciles the lock.
+func InstanceLockCustomizeDiff(d *schema.ResourceDiff) error {
+	if d.Id() == "" {
+		return nil
+	}
+	lock, locked := d.Get("lock").(bool), d.Get("locked").(bool)
+	if lock && !locked {
+		return d.SetNew("locked", true)
+	}
+	if !lock && locked && d.Get("locked_by_terraform").(bool) {
+		return d.SetNew("locked", false)
+	}
+	return nil
+}
+
+// ReconcileInstanceLock locks or unlocks the machine to match the lock attribute. A machine
+// that was locked outside of Terraform is left locked.
+func ReconcileInstanceLock(d *schema.ResourceData, machineManager *maas.MachineManager) error {
+	want, locked := d.Get("lock").(bool), machineManager.Current().Locked
+	switch {
+	case want == locked:
+		return nil
+	case want:
+		if err := machineManager.Lock("Locked by Terraform"); err != nil {
+			return err
+		}
+		return d.Set("locked_by_terraform", true)
+	case !d.Get("locked_by_terraform").(bool):
+		log.Printf("[INFO] Machine %s was locked outside of Terraform, leaving it locked", machineManager.SystemID())
+		return nil
+	}
+	if err := machineManager.Unlock("Unlocked by Terraform"); err != nil {
+		return err
+	}
+	return d.Set("locked_by_terraform", false)
+}
+
+// UnlockInstance removes the lock Terraform placed on the machine before it is released.
+// It returns an error if the machine was locked outside of Terraform.
+func UnlockInstance(d *schema.ResourceData, machineManager *maas.MachineManager) error {
+	if !machineManager.Current().Locked {
+		return nil
+	}
+	if !d.Get("locked_by_terraform").(bool) {
+		return fmt.Errorf("machine %s was locked outside of Terraform and must be unlocked before it can be released",
+			machineManager.SystemID())
+	}
+	return machineManager.Unlock("Unlocked by Terraform")
+}
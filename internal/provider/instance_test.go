@@ -3,8 +3,12 @@ package provider_test
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform/helper/schema"
+
 	. "github.com/roblox/terraform-provider-maas/internal/provider"
 	"github.com/roblox/terraform-provider-maas/internal/tfschema"
+	"github.com/roblox/terraform-provider-maas/pkg/maas"
 )
 
 func TestInstance(t *testing.T) {
@@ -13,3 +17,63 @@ func TestInstance(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestInstance_DeployParams(t *testing.T) {
+	i := &Instance{
+		OSystem:         "custom",
+		DistroSeries:    "rhel8",
+		LicenseKey:      "XXXXX-XXXXX-XXXXX-XXXXX-XXXXX",
+		UserData:        "#cloud-config\n",
+		KernelOptions:   "isolcpus=2-15",
+		InstallKVM:      true,
+		EphemeralDeploy: true,
+		EnableHWSync:    true,
+	}
+	want := &maas.MachineDeployParams{
+		OSystem:         "custom",
+		DistroSeries:    "rhel8",
+		LicenseKey:      "XXXXX-XXXXX-XXXXX-XXXXX-XXXXX",
+		UserData:        "I2Nsb3VkLWNvbmZpZwo=",
+		KernelOptions:   "isolcpus=2-15",
+		InstallKVM:      true,
+		EphemeralDeploy: true,
+		EnableHWSync:    true,
+	}
+	if diff := cmp.Diff(want, i.DeployParams()); diff != "" {
+		t.Errorf("DeployParams() mismatch (-want +got):\n%s", diff)
+	}
+
+	// MaaS picks the image when none is given
+	if diff := cmp.Diff(&maas.MachineDeployParams{}, (&Instance{}).DeployParams()); diff != "" {
+		t.Errorf("DeployParams() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestNewInstance(t *testing.T) {
+	sch := map[string]*schema.Schema{
+		"osystem":        {Type: schema.TypeString, Optional: true},
+		"distro_series":  {Type: schema.TypeString, Optional: true},
+		"kernel_options": {Type: schema.TypeString, Optional: true},
+		"install_rackd":  {Type: schema.TypeBool, Optional: true},
+		"tags":           {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		"pxemac":         {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+	}
+	d := schema.TestResourceDataRaw(t, sch, map[string]interface{}{
+		"osystem":        "ubuntu",
+		"distro_series":  "bionic",
+		"kernel_options": "isolcpus=2-15",
+		"install_rackd":  true,
+		"tags":           []interface{}{"gpu", "ssd"},
+		"pxemac":         []interface{}{"00:00:00:00:00:01"},
+	})
+	want := &Instance{
+		Tags:          []string{"gpu", "ssd"},
+		OSystem:       "ubuntu",
+		DistroSeries:  "bionic",
+		KernelOptions: "isolcpus=2-15",
+		InstallRackD:  true,
+	}
+	if diff := cmp.Diff(want, NewInstance(d)); diff != "" {
+		t.Errorf("NewInstance() mismatch (-want +got):\n%s", diff)
+	}
+}
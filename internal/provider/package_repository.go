@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/crypto/openpgp"
+)
+
+// ppaURL matches the PPA shorthand MaaS accepts instead of a URL, eg ppa:owner/name
+var ppaURL = regexp.MustCompile(`^ppa:[^/\s]+/[^/\s]+$`)
+
+// ValidatePackageRepositoryURL returns an error unless <u> is an absolute http, https or ftp URL,
+// or a PPA given as ppa:owner/name.
+func ValidatePackageRepositoryURL(u string) error {
+	if strings.HasPrefix(u, "ppa:") {
+		if !ppaURL.MatchString(u) {
+			return fmt.Errorf("%q is not a PPA, which is given as ppa:owner/name", u)
+		}
+		return nil
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return err
+	}
+	switch parsed.Scheme {
+	case "http", "https", "ftp":
+	default:
+		return fmt.Errorf("%q must be an http, https or ftp URL", u)
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("%q has no host", u)
+	}
+	return nil
+}
+
+// ValidatePackageRepositoryKey returns an error unless <key> is an ASCII-armored PGP public key,
+// as APT expects the signing key of a repository to be.
+func ValidatePackageRepositoryKey(key string) error {
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(key))
+	if err != nil {
+		return fmt.Errorf("not an ASCII-armored PGP public key: %s", err)
+	}
+	if len(entities) == 0 {
+		return fmt.Errorf("not an ASCII-armored PGP public key: no keys found")
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package provider_test
+
+import (
+	"bytes"
+	"testing"
+
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+	"golang.org/x/crypto/openpgp/packet"
+
+	. "github.com/roblox/terraform-provider-maas/internal/provider"
+)
+
+func TestValidatePackageRepositoryURL(t *testing.T) {
+	tests := []struct {
+		url   string
+		valid bool
+	}{
+		{"http://archive.ubuntu.com/ubuntu", true},
+		{"https://apt.example.com/ubuntu/", true},
+		{"ftp://mirror.example.com/ubuntu", true},
+		{"ppa:maas/stable", true},
+		{"ppa:maas", false},
+		{"ppa:maas/stable/extra", false},
+		{"apt.example.com/ubuntu", false},
+		{"file:///srv/mirror", false},
+		{"https://", false},
+		{"http://exa mple.com/ubuntu", false},
+	}
+	for _, tc := range tests {
+		err := ValidatePackageRepositoryURL(tc.url)
+		if tc.valid && err != nil {
+			t.Errorf("ValidatePackageRepositoryURL(%q) = %s, want no error", tc.url, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("ValidatePackageRepositoryURL(%q) returned no error", tc.url)
+		}
+	}
+}
+
+func TestValidatePackageRepositoryKey(t *testing.T) {
+	signer, err := openpgp.NewEntity("Example Mirror", "", "mirror@example.com", &packet.Config{RSABits: 1024})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := signer.Serialize(w); err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+
+	if err := ValidatePackageRepositoryKey(buf.String()); err != nil {
+		t.Errorf("ValidatePackageRepositoryKey(public key) = %s, want no error", err)
+	}
+	for _, key := range []string{
+		"",
+		"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGq7rQ3Kk0Ez8Hk1o7n9rV1pQfYkq2H0i3bJd3w3v2aB",
+		"-----BEGIN PGP PUBLIC KEY BLOCK-----\n\nbm90IGEga2V5\n-----END PGP PUBLIC KEY BLOCK-----\n",
+	} {
+		if err := ValidatePackageRepositoryKey(key); err == nil {
+			t.Errorf("ValidatePackageRepositoryKey(%q) returned no error", key)
+		}
+	}
+}
@@ -29,21 +29,57 @@ func Provider() terraform.ResourceProvider {
 				Default:     "2.0",
 				Description: "The MAAS API version (default 2.0)",
 			},
+			"failover_api_urls": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Additional MAAS server URLs to use when api_url does not answer",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"maas_instance":           resourceInstance(),
-			"maas_interface_physical": ResourceNetworkInterfacePhysical(),
-			"maas_interface_link":     ResourceNetworkInterfaceLink(),
-			"maas_server":             ResourceServer(),
+			"maas_instance":                   resourceInstance(),
+			"maas_interface_physical":         ResourceNetworkInterfacePhysical(),
+			"maas_interface_link":             ResourceNetworkInterfaceLink(),
+			"maas_server":                     ResourceServer(),
+			"maas_config_setting":             ResourceConfigSetting(),
+			"maas_machine_power":              ResourceMachinePower(),
+			"maas_tag":                        ResourceTag(),
+			"maas_tag_machines":               ResourceTagMachines(),
+			"maas_zone":                       ResourceZone(),
+			"maas_resource_pool":              ResourceResourcePool(),
+			"maas_dns_domain":                 ResourceDNSDomain(),
+			"maas_dns_record":                 ResourceDNSRecord(),
+			"maas_vm_host":                    ResourceVMHost(),
+			"maas_vm":                         ResourceVM(),
+			"maas_device":                     ResourceDevice(),
+			"maas_user":                       ResourceUser(),
+			"maas_ssh_key":                    ResourceSSHKey(),
+			"maas_ssl_key":                    ResourceSSLKey(),
+			"maas_dhcp_snippet":               ResourceDHCPSnippet(),
+			"maas_package_repository":         ResourcePackageRepository(),
+			"maas_node_script":                ResourceNodeScript(),
+			"maas_license_key":                ResourceLicenseKey(),
+			"maas_boot_source":                ResourceBootSource(),
+			"maas_boot_source_selection":      ResourceBootSourceSelection(),
+			"maas_rack_controller_image_sync": ResourceRackControllerImageSync(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"maas_subnet":          DataSubnet(),
-			"maas_rack_controller": DataRackController(),
+			"maas_subnet":             DataSubnet(),
+			"maas_rack_controller":    DataRackController(),
+			"maas_rack_controllers":   DataRackControllers(),
+			"maas_region_controllers": DataRegionControllers(),
+			"maas_machine_results":    DataMachineResults(),
+			"maas_zone":               DataZone(),
+			"maas_resource_pool":      DataResourcePool(),
+			"maas_vm_hosts":           DataVMHosts(),
+			"maas_boot_images":        DataBootImages(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
-	return gmaw.GetClient(d.Get("api_url").(string), d.Get("api_version").(string), d.Get("api_key").(string))
+	failoverURLs := listToStrings(d.Get("failover_api_urls").([]interface{}))
+	return gmaw.GetClient(
+		d.Get("api_url").(string), d.Get("api_key").(string), d.Get("api_version").(string), failoverURLs...)
 }
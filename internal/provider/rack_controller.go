@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// rackControllerRequiredServices are the services a rack controller needs running to boot machines
+var rackControllerRequiredServices = []string{"rackd", "http", "tftp"}
+
+// rackControllerOptionalServices are the services that are off or managed by the region when they are
+// not used (eg dhcpd on a rack controller that does not serve DHCP), but must not have failed
+var rackControllerOptionalServices = []string{"dhcpd", "ntp_rack"}
+
+// RackControllerUnhealthyServices returns the names of the services of a rack controller that are not
+// healthy, sorted. The rackd, http and tftp services must be running, while the dhcpd and ntp_rack
+// services must not be dead or degraded. A service that is not reported at all is unhealthy.
+func RackControllerUnhealthyServices(ctrl *entity.RackController) []string {
+	services := rackControllerServices(ctrl.ServiceSet)
+	var res []string
+	for _, name := range rackControllerRequiredServices {
+		if services[name] != "running" {
+			res = append(res, name)
+		}
+	}
+	for _, name := range rackControllerOptionalServices {
+		status, ok := services[name]
+		if !ok || status == "dead" || status == "degraded" {
+			res = append(res, name)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// rackControllerVLANs returns the VLANs a rack controller serves as the primary or secondary
+// rack controller, as found on its interfaces, without duplicates.
+func rackControllerVLANs(ctrl *entity.RackController) []entity.VLAN {
+	var res []entity.VLAN
+	seen := make(map[int]bool)
+	for _, iface := range ctrl.InterfaceSet {
+		vlan := iface.VLAN
+		if seen[vlan.ID] || (vlan.PrimaryRack != ctrl.SystemID && vlan.SecondaryRack != ctrl.SystemID) {
+			continue
+		}
+		seen[vlan.ID] = true
+		res = append(res, vlan)
+	}
+	return res
+}
+
+// rackControllerInterfaceSchema returns the schema of an interface of a rack controller.
+func rackControllerInterfaceSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mac_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vlan_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"vid": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"fabric": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnets": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// rackControllerInterfaces flattens the interfaces of a rack controller, with the CIDRs
+// of the subnets each interface is linked to.
+func rackControllerInterfaces(ifaces []entity.NetworkInterface) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(ifaces))
+	for _, iface := range ifaces {
+		subnets := make([]string, 0, len(iface.Links))
+		for _, link := range iface.Links {
+			if link.Subnet.CIDR != "" {
+				subnets = append(subnets, link.Subnet.CIDR)
+			}
+		}
+		res = append(res, map[string]interface{}{
+			"id":          iface.ID,
+			"name":        iface.Name,
+			"type":        iface.Type,
+			"mac_address": iface.MACAddress,
+			"vlan_id":     iface.VLAN.ID,
+			"vid":         iface.VLAN.VID,
+			"fabric":      iface.VLAN.Fabric,
+			"subnets":     subnets,
+		})
+	}
+	return res
+}
+
+// rackControllerServices returns the status of each service of a rack controller, by name.
+func rackControllerServices(services []entity.MachineServiceSet) map[string]string {
+	res := make(map[string]string, len(services))
+	for _, service := range services {
+		res[service.Name] = service.Status
+	}
+	return res
+}
+
+// rackControllerIPAddresses returns the IP addresses of a rack controller as strings.
+func rackControllerIPAddresses(ctrl *entity.RackController) []string {
+	res := make([]string, 0, len(ctrl.IPAddresses))
+	for _, ip := range ctrl.IPAddresses {
+		res = append(res, ip.String())
+	}
+	return res
+}
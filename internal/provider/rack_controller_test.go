@@ -0,0 +1,41 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	. "github.com/roblox/terraform-provider-maas/internal/provider"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestRackControllerUnhealthyServices(t *testing.T) {
+	var ctrls []entity.RackController
+	if err := helper.TestdataFromJSON("maas/rack_controllers.json", &ctrls); err != nil {
+		t.Fatal(err)
+	}
+	ctrl := &ctrls[0]
+
+	// The sample rack controller is healthy: ntp_rack is managed by the region and reported as unknown
+	if got := RackControllerUnhealthyServices(ctrl); len(got) != 0 {
+		t.Fatalf("RackControllerUnhealthyServices() = %q, want none", got)
+	}
+
+	for idx := range ctrl.ServiceSet {
+		switch ctrl.ServiceSet[idx].Name {
+		case "tftp":
+			ctrl.ServiceSet[idx].Status = "dead"
+		case "dhcpd":
+			ctrl.ServiceSet[idx].Status = "degraded"
+		case "ntp_rack":
+			ctrl.ServiceSet[idx].Status = "off"
+		}
+	}
+	ctrl.ServiceSet = ctrl.ServiceSet[:len(ctrl.ServiceSet)-1] // rackd is the last service
+
+	want := []string{"dhcpd", "rackd", "tftp"}
+	if diff := cmp.Diff(want, RackControllerUnhealthyServices(ctrl)); diff != "" {
+		t.Fatalf("RackControllerUnhealthyServices() mismatch (-want +got):\n%s", diff)
+	}
+}
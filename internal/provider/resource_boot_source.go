@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+)
+
+// ResourceBootSource manages a MaaS Boot Source, ie a simplestreams mirror that boot images are imported from
+func ResourceBootSource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBootSourceCreate,
+		Read:   resourceBootSourceRead,
+		Update: resourceBootSourceUpdate,
+		Delete: resourceBootSourceDelete,
+
+		Schema: map[string]*schema.Schema{
+			"url": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"keyring_filename": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"keyring_data"},
+			},
+			"keyring_data": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"keyring_filename"},
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					if _, err := base64.StdEncoding.DecodeString(val.(string)); err != nil {
+						errs = append(errs, fmt.Errorf("%q must be base64 encoded: %s", key, err))
+					}
+					return
+				},
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceBootSourceCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	p, err := resourceBootSourceParams(d)
+	if err != nil {
+		return err
+	}
+	source, err := gmaw.NewBootSources(mo).Post(p)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.Itoa(source.ID))
+	return resourceBootSourceRead(d, m)
+}
+
+// resourceBootSourceRead reads the boot source. The keyring data cannot be compared with the
+// configuration, so it is left as it is in the state.
+func resourceBootSourceRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	source, err := gmaw.NewBootSource(mo).Get(id)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	if err := d.Set("url", source.URL); err != nil {
+		return err
+	}
+	return d.Set("keyring_filename", source.KeyringFilename)
+}
+
+func resourceBootSourceUpdate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	p, err := resourceBootSourceParams(d)
+	if err != nil {
+		return err
+	}
+	if !d.HasChange("keyring_data") {
+		p.KeyringData = nil
+	}
+	if _, err := gmaw.NewBootSource(mo).Put(id, p); err != nil {
+		return err
+	}
+	return resourceBootSourceRead(d, m)
+}
+
+// resourceBootSourceDelete removes the boot source along with its selections.
+// The images that were imported from it are removed by MaaS on the next import.
+func resourceBootSourceDelete(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	if err := gmaw.NewBootSource(mo).Delete(id); err != nil && !isNotFound(err) {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceBootSourceParams returns the parameters for creating or updating a boot source
+// from the resource data, with the keyring data decoded.
+func resourceBootSourceParams(d *schema.ResourceData) (*params.BootSource, error) {
+	keyring, err := base64.StdEncoding.DecodeString(d.Get("keyring_data").(string))
+	if err != nil {
+		return nil, err
+	}
+	return &params.BootSource{
+		URL:             d.Get("url").(string),
+		KeyringFilename: d.Get("keyring_filename").(string),
+		KeyringData:     keyring,
+	}, nil
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+)
+
+// ResourceBootSourceSelection manages a MaaS Boot Source Selection, ie an OS release
+// that is imported from a boot source
+func ResourceBootSourceSelection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBootSourceSelectionCreate,
+		Read:   resourceBootSourceSelectionRead,
+		Update: resourceBootSourceSelectionUpdate,
+		Delete: resourceBootSourceSelectionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"boot_source": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"os": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "ubuntu",
+			},
+			"release": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arches": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"subarches": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"labels": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: resourceBootSourceSelectionImport,
+		},
+	}
+}
+
+func resourceBootSourceSelectionCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	bootSourceID := d.Get("boot_source").(int)
+	selection, err := gmaw.NewBootSourceSelections(mo).Post(bootSourceID, resourceBootSourceSelectionParams(d))
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.Itoa(selection.ID))
+	return resourceBootSourceSelectionRead(d, m)
+}
+
+func resourceBootSourceSelectionRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	selection, err := gmaw.NewBootSourceSelection(mo).Get(d.Get("boot_source").(int), id)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	tfstate := map[string]interface{}{
+		"os":        selection.OS,
+		"release":   selection.Release,
+		"arches":    selection.Arches,
+		"subarches": selection.Subarches,
+		"labels":    selection.Labels,
+	}
+	if selection.BootSourceID != 0 {
+		tfstate["boot_source"] = selection.BootSourceID
+	}
+	for k, v := range tfstate {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceBootSourceSelectionUpdate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	_, err = gmaw.NewBootSourceSelection(mo).Put(d.Get("boot_source").(int), id, resourceBootSourceSelectionParams(d))
+	if err != nil {
+		return err
+	}
+	return resourceBootSourceSelectionRead(d, m)
+}
+
+// resourceBootSourceSelectionDelete removes the selection. The images of the release are
+// removed by MaaS on the next import.
+func resourceBootSourceSelectionDelete(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	err = gmaw.NewBootSourceSelection(mo).Delete(d.Get("boot_source").(int), id)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceBootSourceSelectionImport splits the ID, given as <boot source id>:<id>, since
+// the boot source cannot be looked up from the selection.
+func resourceBootSourceSelectionImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	idx := strings.Index(d.Id(), ":")
+	if idx < 0 {
+		return nil, fmt.Errorf("the ID must be in the form <boot source id>:<id> (got '%s')", d.Id())
+	}
+	bootSourceID, err := strconv.Atoi(d.Id()[:idx])
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("boot_source", bootSourceID); err != nil {
+		return nil, err
+	}
+	d.SetId(d.Id()[idx+1:])
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceBootSourceSelectionParams returns the parameters for creating or updating a selection
+// from the resource data. Lists that are not configured are left to MaaS, which defaults them to "*".
+func resourceBootSourceSelectionParams(d *schema.ResourceData) *params.BootSourceSelection {
+	return &params.BootSourceSelection{
+		OS:        d.Get("os").(string),
+		Release:   d.Get("release").(string),
+		Arches:    setToStrings(d.Get("arches").(*schema.Set)),
+		Subarches: setToStrings(d.Get("subarches").(*schema.Set)),
+		Labels:    setToStrings(d.Get("labels").(*schema.Set)),
+	}
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+)
+
+// ResourceConfigSetting manages a single MaaS configuration key by name, for the keys
+// the maas_server resource does not know about. The value the key had before it was
+// managed is restored when the resource is destroyed.
+func ResourceConfigSetting() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceConfigSettingCreate,
+		Read:   resourceConfigSettingRead,
+		Update: resourceConfigSettingUpdate,
+		Delete: resourceConfigSettingDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"value": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: resourceConfigSettingDiffSuppress,
+			},
+			"normalize": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(ServerConfigString),
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					switch ServerConfigKind(val.(string)) {
+					case ServerConfigString, ServerConfigList, ServerConfigBool, ServerConfigInt:
+					default:
+						errs = append(errs, fmt.Errorf("%q must be 'string', 'list', 'bool' or 'int' (got '%s')", key, val))
+					}
+					return
+				},
+			},
+			"previous_value": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: resourceConfigSettingImport,
+		},
+	}
+}
+
+// resourceConfigSettingDiffSuppress ignores differences in the way MaaS formats a value,
+// according to the normalize mode of the setting.
+func resourceConfigSettingDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	kind := ServerConfigKind(d.Get("normalize").(string))
+	return kind.Normalize(old) == kind.Normalize(new)
+}
+
+// resourceConfigSettingGet returns the current value of the setting <name>, formatted
+// according to <kind>.
+func resourceConfigSettingGet(client *gmaw.MAASServer, name string, kind ServerConfigKind) (string, error) {
+	raw, err := client.Get(name)
+	if err != nil {
+		return "", err
+	}
+	val, err := kind.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("%s: %s", name, err)
+	}
+	return kind.Format(val), nil
+}
+
+func resourceConfigSettingCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	client := gmaw.NewMAASServer(mo)
+	name := d.Get("name").(string)
+
+	previous, err := resourceConfigSettingGet(client, name, ServerConfigKind(d.Get("normalize").(string)))
+	if err != nil {
+		return err
+	}
+	if err := client.Post(name, d.Get("value").(string)); err != nil {
+		return err
+	}
+	d.SetId(name)
+	if err := d.Set("previous_value", previous); err != nil {
+		return err
+	}
+	return resourceConfigSettingRead(d, m)
+}
+
+func resourceConfigSettingRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	client := gmaw.NewMAASServer(mo)
+	kind := ServerConfigKind(d.Get("normalize").(string))
+
+	val, err := resourceConfigSettingGet(client, d.Id(), kind)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("name", d.Id()); err != nil {
+		return err
+	}
+	return d.Set("value", val)
+}
+
+func resourceConfigSettingUpdate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	client := gmaw.NewMAASServer(mo)
+	if d.HasChange("value") {
+		if err := client.Post(d.Id(), d.Get("value").(string)); err != nil {
+			return err
+		}
+	}
+	return resourceConfigSettingRead(d, m)
+}
+
+// resourceConfigSettingDelete restores the previous value of the setting.
+func resourceConfigSettingDelete(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	client := gmaw.NewMAASServer(mo)
+	return client.Post(d.Id(), d.Get("previous_value").(string))
+}
+
+// resourceConfigSettingImport records the current value of an imported setting as its
+// previous value, since the value it had before it was managed is unknown. Destroying
+// an imported setting therefore leaves it as it is.
+func resourceConfigSettingImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	mo := maasClient(m)
+	client := gmaw.NewMAASServer(mo)
+	if err := d.Set("normalize", string(ServerConfigString)); err != nil {
+		return nil, err
+	}
+	previous, err := resourceConfigSettingGet(client, d.Id(), ServerConfigString)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("previous_value", previous); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,64 @@
+package provider_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jarcoal/httpmock"
+
+	. "github.com/roblox/terraform-provider-maas/internal/provider"
+)
+
+func TestResourceConfigSetting_CreateDelete(t *testing.T) {
+	client := testClient(t)
+	defer httpmock.DeactivateAndReset()
+	res := Provider().(*schema.Provider).ResourcesMap["maas_config_setting"]
+	maasURL := testAPIURL + "/api/2.0/maas/"
+
+	// MaaS keeps the value as it was set, which the list format rewrites
+	value, posted := `"8.8.8.8, 8.8.4.4"`, []string{}
+	httpmock.RegisterResponderWithQuery("GET", maasURL, url.Values{"op": {"get_config"}, "name": {"upstream_dns"}},
+		func(*http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, value), nil
+		})
+	httpmock.RegisterResponder("POST", maasURL+"?op=set_config",
+		func(req *http.Request) (*http.Response, error) {
+			if err := req.ParseForm(); err != nil {
+				return nil, err
+			}
+			raw, err := json.Marshal(req.Form.Get("value"))
+			if err != nil {
+				return nil, err
+			}
+			value = string(raw)
+			posted = append(posted, req.Form.Get("value"))
+			return httpmock.NewStringResponse(http.StatusOK, "OK"), nil
+		})
+
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":      "upstream_dns",
+		"value":     "1.1.1.1 1.0.0.1",
+		"normalize": "list",
+	})
+	if err := res.Create(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("8.8.8.8 8.8.4.4", d.Get("previous_value")); diff != "" {
+		t.Errorf("previous_value mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff("1.1.1.1 1.0.0.1", d.Get("value")); diff != "" {
+		t.Errorf("value mismatch (-want +got):\n%s", diff)
+	}
+
+	// The previous value is restored in the format of the setting
+	if err := res.Delete(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"1.1.1.1 1.0.0.1", "8.8.8.8 8.8.4.4"}, posted); diff != "" {
+		t.Errorf("posted values mismatch (-want +got):\n%s", diff)
+	}
+}
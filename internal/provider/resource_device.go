@@ -0,0 +1,332 @@
+package provider
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// ResourceDevice manages a MaaS Device, which is a node MaaS does not deploy, such as a switch
+// management port, a PDU or a BMC, but whose IP addresses and DNS names it allocates.
+func ResourceDevice() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDeviceCreate,
+		Read:   resourceDeviceRead,
+		Update: resourceDeviceUpdate,
+		Delete: resourceDeviceDelete,
+
+		Schema: map[string]*schema.Schema{
+			"hostname": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"parent": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"mac_addresses": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateMACAddress,
+				},
+			},
+			"ip_address": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mac_address": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateMACAddress,
+						},
+						"subnet_id": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"ip_address": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+								v := val.(string)
+								if ip := net.ParseIP(v); ip == nil {
+									errs = append(errs, fmt.Errorf("%q must be a valid IP address (got '%s')", key, v))
+								}
+								return
+							},
+						},
+					},
+				},
+			},
+			"fqdn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_addresses": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+// validateMACAddress ensures a MAC address is in the form MaaS returns it in,
+// so that it can be compared with the MAC addresses of the interfaces.
+func validateMACAddress(val interface{}, key string) (warns []string, errs []error) {
+	v := val.(string)
+	if mac, err := net.ParseMAC(v); err != nil || mac.String() != v {
+		errs = append(errs, fmt.Errorf("%q must be a lowercase MAC address like '00:16:3e:4a:10:01' (got '%s')", key, v))
+	}
+	return
+}
+
+// deviceLink is a static IP address of a device, as configured in an ip_address block.
+type deviceLink struct {
+	MACAddress string
+	SubnetID   int
+	IPAddress  string
+}
+
+// matches returns true if <l> and <other> are the same link. An empty IP address
+// matches any IP address, since MaaS picks one when it is not set.
+func (l deviceLink) matches(other deviceLink) bool {
+	return l.MACAddress == other.MACAddress && l.SubnetID == other.SubnetID &&
+		(l.IPAddress == "" || other.IPAddress == "" || l.IPAddress == other.IPAddress)
+}
+
+func resourceDeviceCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	p := resourceDeviceParams(d)
+	p.MACAddresses = setToStrings(d.Get("mac_addresses").(*schema.Set))
+	device, err := gmaw.NewDevices(mo).Post(p)
+	if err != nil {
+		return err
+	}
+	d.SetId(device.SystemID)
+
+	for _, link := range resourceDeviceLinks(d.Get("ip_address")) {
+		if err := resourceDeviceLink(mo, device, link); err != nil {
+			return err
+		}
+	}
+	return resourceDeviceRead(d, m)
+}
+
+func resourceDeviceRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	device, err := gmaw.NewDevice(mo).Get(d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	macAddresses := make([]string, 0, len(device.InterfaceSet))
+	for _, iface := range device.InterfaceSet {
+		macAddresses = append(macAddresses, iface.MACAddress)
+	}
+	ipAddresses := make([]string, 0, len(device.IPAddresses))
+	for _, ip := range device.IPAddresses {
+		ipAddresses = append(ipAddresses, ip.String())
+	}
+
+	// Keep the static links in the order they are configured in, so that the plan stays empty
+	found := resourceDeviceStaticLinks(device)
+	links := make([]map[string]interface{}, 0, len(found))
+	for _, link := range append(resourceDeviceLinks(d.Get("ip_address")), found...) {
+		for idx, f := range found {
+			if link.matches(f) {
+				links = append(links, map[string]interface{}{
+					"mac_address": f.MACAddress,
+					"subnet_id":   f.SubnetID,
+					"ip_address":  f.IPAddress,
+				})
+				found = append(found[:idx], found[idx+1:]...)
+				break
+			}
+		}
+	}
+
+	tfstate := map[string]interface{}{
+		"hostname":      device.Hostname,
+		"domain":        device.Domain.Name,
+		"description":   device.Description,
+		"parent":        device.Parent,
+		"mac_addresses": macAddresses,
+		"ip_address":    links,
+		"fqdn":          device.FQDN,
+		"ip_addresses":  ipAddresses,
+	}
+	for k, v := range tfstate {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceDeviceUpdate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	client := gmaw.NewDevice(mo)
+	if d.HasChange("hostname") || d.HasChange("domain") || d.HasChange("description") || d.HasChange("parent") {
+		if _, err := client.Put(d.Id(), resourceDeviceParams(d)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("ip_address") {
+		device, err := client.Get(d.Id())
+		if err != nil {
+			return err
+		}
+		o, n := d.GetChange("ip_address")
+		oldLinks, newLinks := resourceDeviceLinks(o), resourceDeviceLinks(n)
+
+		// Remove the links that are gone first, so that their IP addresses can be reused
+		for _, link := range oldLinks {
+			if !resourceDeviceHasLink(newLinks, link) {
+				if err := resourceDeviceUnlink(mo, device, link); err != nil {
+					return err
+				}
+			}
+		}
+		for _, link := range newLinks {
+			if !resourceDeviceHasLink(oldLinks, link) {
+				if err := resourceDeviceLink(mo, device, link); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return resourceDeviceRead(d, m)
+}
+
+func resourceDeviceDelete(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	if err := gmaw.NewDevice(mo).Delete(d.Id()); err != nil && !isNotFound(err) {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceDeviceParams returns the parameters for creating or updating a device from the resource data.
+func resourceDeviceParams(d *schema.ResourceData) *params.Device {
+	return &params.Device{
+		Hostname:    d.Get("hostname").(string),
+		Domain:      d.Get("domain").(string),
+		Description: d.Get("description").(string),
+		Parent:      d.Get("parent").(string),
+	}
+}
+
+// resourceDeviceLinks returns the links configured in the ip_address blocks <v>.
+func resourceDeviceLinks(v interface{}) []deviceLink {
+	blocks := v.([]interface{})
+	res := make([]deviceLink, 0, len(blocks))
+	for _, block := range blocks {
+		b := block.(map[string]interface{})
+		res = append(res, deviceLink{
+			MACAddress: b["mac_address"].(string),
+			SubnetID:   b["subnet_id"].(int),
+			IPAddress:  b["ip_address"].(string),
+		})
+	}
+	return res
+}
+
+// resourceDeviceHasLink returns true if <link> is one of <links>.
+func resourceDeviceHasLink(links []deviceLink, link deviceLink) bool {
+	for _, l := range links {
+		if l.matches(link) {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceDeviceStaticLinks returns the static links of all of the interfaces of <device>.
+func resourceDeviceStaticLinks(device *entity.Device) []deviceLink {
+	var res []deviceLink
+	for _, iface := range device.InterfaceSet {
+		for _, link := range iface.Links {
+			if strings.EqualFold(link.Mode, "static") && link.Subnet.ID > 0 {
+				res = append(res, deviceLink{
+					MACAddress: iface.MACAddress,
+					SubnetID:   link.Subnet.ID,
+					IPAddress:  link.IPAddress.String(),
+				})
+			}
+		}
+	}
+	return res
+}
+
+// resourceDeviceInterface returns the interface of <device> with the MAC address of <link>.
+func resourceDeviceInterface(device *entity.Device, link deviceLink) (*entity.NetworkInterface, error) {
+	for idx := range device.InterfaceSet {
+		if device.InterfaceSet[idx].MACAddress == link.MACAddress {
+			return &device.InterfaceSet[idx], nil
+		}
+	}
+	return nil, fmt.Errorf("device %s has no interface with MAC address %s", device.SystemID, link.MACAddress)
+}
+
+// resourceDeviceLink gives the interface of <device> a static IP address on a subnet.
+func resourceDeviceLink(mo *gomaasapi.MAASObject, device *entity.Device, link deviceLink) error {
+	iface, err := resourceDeviceInterface(device, link)
+	if err != nil {
+		return err
+	}
+	_, err = gmaw.NewNetworkInterface(mo).LinkSubnet(device.SystemID, iface.ID, &params.NetworkInterfaceLink{
+		Mode:      "STATIC",
+		Subnet:    link.SubnetID,
+		IPAddress: net.ParseIP(link.IPAddress),
+	})
+	return err
+}
+
+// resourceDeviceUnlink releases the static IP address of an interface of <device>.
+// Links that are already gone are ignored.
+func resourceDeviceUnlink(mo *gomaasapi.MAASObject, device *entity.Device, link deviceLink) error {
+	for _, iface := range device.InterfaceSet {
+		if iface.MACAddress != link.MACAddress {
+			continue
+		}
+		for _, l := range iface.Links {
+			found := deviceLink{MACAddress: iface.MACAddress, SubnetID: l.Subnet.ID, IPAddress: l.IPAddress.String()}
+			if strings.EqualFold(l.Mode, "static") && link.matches(found) {
+				_, err := gmaw.NewNetworkInterface(mo).UnlinkSubnet(device.SystemID, iface.ID, l.ID)
+				return err
+			}
+		}
+	}
+	return nil
+}
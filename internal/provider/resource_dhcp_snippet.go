@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+)
+
+// ResourceDHCPSnippet manages a MaaS DHCP Snippet, which adds custom configuration to the
+// DHCP server MaaS runs, either globally or for a single subnet or node.
+func ResourceDHCPSnippet() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceDHCPSnippetCreate,
+		Read:          resourceDHCPSnippetRead,
+		Update:        resourceDHCPSnippetUpdate,
+		Delete:        resourceDHCPSnippetDelete,
+		CustomizeDiff: resourceDHCPSnippetCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"value": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressSurroundingSpace,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"global": &schema.Schema{
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"subnet_id", "system_id"},
+			},
+			"subnet_id": &schema.Schema{
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"global", "system_id"},
+			},
+			"system_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"global", "subnet_id"},
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+// resourceDHCPSnippetCustomizeDiff ensures the snippet has a scope when it is planned,
+// since MaaS would otherwise create a snippet that applies nowhere.
+func resourceDHCPSnippetCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	// A scope that is only known at apply time, eg the ID of a subnet created alongside, is checked by MaaS
+	if !d.NewValueKnown("subnet_id") || !d.NewValueKnown("system_id") {
+		return nil
+	}
+	if !d.Get("global").(bool) && d.Get("subnet_id").(int) == 0 && d.Get("system_id").(string) == "" {
+		return fmt.Errorf("one of global, subnet_id or system_id must be set")
+	}
+	return nil
+}
+
+func resourceDHCPSnippetCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	snippet, err := gmaw.NewDHCPSnippets(mo).Post(resourceDHCPSnippetParams(d))
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.Itoa(snippet.ID))
+	return resourceDHCPSnippetRead(d, m)
+}
+
+func resourceDHCPSnippetRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	snippet, err := gmaw.NewDHCPSnippet(mo).Get(id)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	tfstate := map[string]interface{}{
+		"name":        snippet.Name,
+		"value":       snippet.Value,
+		"description": snippet.Description,
+		"enabled":     snippet.Enabled,
+		"global":      snippet.GlobalSnippet,
+		"subnet_id":   snippet.Subnet.ID,
+		"system_id":   snippet.Node.SystemID,
+	}
+	for k, v := range tfstate {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceDHCPSnippetUpdate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	if _, err := gmaw.NewDHCPSnippet(mo).Put(id, resourceDHCPSnippetParams(d)); err != nil {
+		return err
+	}
+	return resourceDHCPSnippetRead(d, m)
+}
+
+func resourceDHCPSnippetDelete(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	if err := gmaw.NewDHCPSnippet(mo).Delete(id); err != nil && !isNotFound(err) {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceDHCPSnippetParams returns the parameters for creating or updating a snippet from the resource data.
+func resourceDHCPSnippetParams(d *schema.ResourceData) *params.DHCPSnippet {
+	return &params.DHCPSnippet{
+		Name:          d.Get("name").(string),
+		Value:         d.Get("value").(string),
+		Description:   d.Get("description").(string),
+		Enabled:       d.Get("enabled").(bool),
+		GlobalSnippet: d.Get("global").(bool),
+		Subnet:        d.Get("subnet_id").(int),
+		Node:          d.Get("system_id").(string),
+	}
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+)
+
+// ResourceDNSDomain manages a MaaS DNS Domain
+func ResourceDNSDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSDomainCreate,
+		Read:   resourceDNSDomainRead,
+		Update: resourceDNSDomainUpdate,
+		Delete: resourceDNSDomainDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"authoritative": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"is_default": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceDNSDomainCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	domain, err := gmaw.NewDomains(mo).Post(resourceDNSDomainParams(d))
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.Itoa(domain.ID))
+
+	if d.Get("is_default").(bool) {
+		if _, err := gmaw.NewDomain(mo).SetDefault(domain.ID); err != nil {
+			return err
+		}
+	}
+	return resourceDNSDomainRead(d, m)
+}
+
+func resourceDNSDomainRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	domain, err := gmaw.NewDomain(mo).Get(id)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	if err := d.Set("name", domain.Name); err != nil {
+		return err
+	}
+	if err := d.Set("authoritative", domain.Authoritative); err != nil {
+		return err
+	}
+	if err := d.Set("ttl", domain.TTL); err != nil {
+		return err
+	}
+	return d.Set("is_default", domain.IsDefault)
+}
+
+func resourceDNSDomainUpdate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	if d.HasChange("name") || d.HasChange("authoritative") || d.HasChange("ttl") {
+		if _, err := gmaw.NewDomain(mo).Put(id, resourceDNSDomainParams(d)); err != nil {
+			return err
+		}
+	}
+
+	// There is always exactly one default domain, so it can only be moved to another domain
+	if d.HasChange("is_default") && d.Get("is_default").(bool) {
+		if _, err := gmaw.NewDomain(mo).SetDefault(id); err != nil {
+			return err
+		}
+	}
+	return resourceDNSDomainRead(d, m)
+}
+
+func resourceDNSDomainDelete(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	if err := gmaw.NewDomain(mo).Delete(id); err != nil && !isNotFound(err) {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceDNSDomainParams returns the parameters for creating or updating a domain from the resource data.
+func resourceDNSDomainParams(d *schema.ResourceData) *params.Domain {
+	return &params.Domain{
+		Name:          d.Get("name").(string),
+		TTL:           d.Get("ttl").(int),
+		Authoritative: d.Get("authoritative").(bool),
+	}
+}
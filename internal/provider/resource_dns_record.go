@@ -0,0 +1,358 @@
+package provider
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// dnsAddressMutex serializes the changes to the addresses of DNS resources, since several
+// address records with the same name share the DNS resource and update its addresses in turn.
+var dnsAddressMutex sync.Mutex
+
+// ResourceDNSRecord manages a record in a MaaS DNS Domain.
+// Address records (A and AAAA) are addresses of MaaS DNS resources, and the other types are
+// DNS resource records, so the ID of the resource refers to one or the other. Address records
+// with the same name share the DNS resource, and each of them only adds and removes its own address.
+func ResourceDNSRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSRecordCreate,
+		Read:   resourceDNSRecordRead,
+		Update: resourceDNSRecordUpdate,
+		Delete: resourceDNSRecordDelete,
+
+		Schema: map[string]*schema.Schema{
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					switch v {
+					case "A", "AAAA", "CNAME", "TXT", "SRV", "MX":
+					default:
+						errs = append(errs, fmt.Errorf("%q must be 'A', 'AAAA', 'CNAME', 'TXT', 'SRV' or 'MX' (got '%s')",
+							key, v))
+					}
+					return
+				},
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"data": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"fqdn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: resourceDNSRecordImport,
+		},
+	}
+}
+
+func resourceDNSRecordCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	if err := resourceDNSRecordValidate(d); err != nil {
+		return err
+	}
+
+	var id int
+	if resourceDNSRecordIsAddress(d) {
+		dnsAddressMutex.Lock()
+		defer dnsAddressMutex.Unlock()
+		res, err := resourceDNSRecordFindResource(d, m)
+		if err != nil {
+			return err
+		}
+		data := d.Get("data").(string)
+		if res == nil {
+			res, err = gmaw.NewDNSResources(mo).Post(&params.DNSResource{
+				Name:        d.Get("name").(string),
+				Domain:      d.Get("domain").(string),
+				IPAddresses: []string{data},
+				AddressTTL:  d.Get("ttl").(int),
+			})
+		} else {
+			res, err = gmaw.NewDNSResource(mo).Put(res.ID, &params.DNSResource{
+				IPAddresses: resourceDNSRecordReplaceAddress(res, "", data),
+				AddressTTL:  d.Get("ttl").(int),
+			})
+		}
+		if err != nil {
+			return err
+		}
+		id = res.ID
+	} else {
+		record, err := gmaw.NewDNSResourceRecords(mo).Post(&params.DNSResourceRecord{
+			Name:   d.Get("name").(string),
+			Domain: d.Get("domain").(string),
+			RRType: d.Get("type").(string),
+			RRData: d.Get("data").(string),
+			TTL:    d.Get("ttl").(int),
+		})
+		if err != nil {
+			return err
+		}
+		id = record.ID
+	}
+	d.SetId(strconv.Itoa(id))
+	return resourceDNSRecordRead(d, m)
+}
+
+func resourceDNSRecordRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var fqdn, data string
+	var ttl int
+	if resourceDNSRecordIsAddress(d) {
+		res, err := gmaw.NewDNSResource(mo).Get(id)
+		if err != nil {
+			if isNotFound(err) {
+				d.SetId("")
+				return nil
+			}
+			return err
+		}
+		fqdn, ttl = res.FQDN, res.AddressTTL
+
+		// An imported record takes the first address of its family, and a record whose
+		// address was removed outside of Terraform is gone
+		data = d.Get("data").(string)
+		addrs := resourceDNSRecordAddresses(res, d.Get("type").(string))
+		if data == "" && len(addrs) > 0 {
+			data = addrs[0]
+		}
+		if !resourceDNSRecordHasAddress(addrs, data) {
+			d.SetId("")
+			return nil
+		}
+	} else {
+		record, err := gmaw.NewDNSResourceRecord(mo).Get(id)
+		if err != nil {
+			if isNotFound(err) {
+				d.SetId("")
+				return nil
+			}
+			return err
+		}
+		fqdn, ttl, data = record.FQDN, record.TTL, record.RRData
+	}
+
+	// The FQDN is split on the configured domain, or on the MaaS domains after an import
+	domains := []string{d.Get("domain").(string)}
+	if domains[0] == "" {
+		res, err := gmaw.NewDomains(mo).Get()
+		if err != nil {
+			return err
+		}
+		for _, domain := range res {
+			domains = append(domains, domain.Name)
+		}
+	}
+	name, domain := SplitFQDN(fqdn, domains)
+	if err := d.Set("name", name); err != nil {
+		return err
+	}
+	if err := d.Set("domain", domain); err != nil {
+		return err
+	}
+	if err := d.Set("fqdn", fqdn); err != nil {
+		return err
+	}
+	if err := d.Set("data", data); err != nil {
+		return err
+	}
+	return d.Set("ttl", ttl)
+}
+
+func resourceDNSRecordUpdate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	if err := resourceDNSRecordValidate(d); err != nil {
+		return err
+	}
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resourceDNSRecordIsAddress(d) {
+		dnsAddressMutex.Lock()
+		defer dnsAddressMutex.Unlock()
+		var res *entity.DNSResource
+		if res, err = gmaw.NewDNSResource(mo).Get(id); err != nil {
+			return err
+		}
+		oldAddr, newAddr := d.GetChange("data")
+		_, err = gmaw.NewDNSResource(mo).Put(id, &params.DNSResource{
+			IPAddresses: resourceDNSRecordReplaceAddress(res, oldAddr.(string), newAddr.(string)),
+			AddressTTL:  d.Get("ttl").(int),
+		})
+	} else {
+		_, err = gmaw.NewDNSResourceRecord(mo).Put(id, &params.DNSResourceRecord{
+			RRType: d.Get("type").(string),
+			RRData: d.Get("data").(string),
+			TTL:    d.Get("ttl").(int),
+		})
+	}
+	if err != nil {
+		return err
+	}
+	return resourceDNSRecordRead(d, m)
+}
+
+func resourceDNSRecordDelete(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	if resourceDNSRecordIsAddress(d) {
+		err = resourceDNSRecordDeleteAddress(d, m, id)
+	} else {
+		err = gmaw.NewDNSResourceRecord(mo).Delete(id)
+	}
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceDNSRecordDeleteAddress removes the address of the record from the DNS resource <id>.
+// The DNS resource is deleted along with its last address, unless it also holds other records.
+func resourceDNSRecordDeleteAddress(d *schema.ResourceData, m interface{}, id int) error {
+	mo := maasClient(m)
+	dnsAddressMutex.Lock()
+	defer dnsAddressMutex.Unlock()
+	res, err := gmaw.NewDNSResource(mo).Get(id)
+	if err != nil {
+		return err
+	}
+	addrs := resourceDNSRecordReplaceAddress(res, d.Get("data").(string), "")
+	if len(addrs) == 0 && len(res.ResourceRecords) == 0 {
+		return gmaw.NewDNSResource(mo).Delete(id)
+	}
+	_, err = gmaw.NewDNSResource(mo).Put(id, &params.DNSResource{IPAddresses: addrs})
+	return err
+}
+
+// resourceDNSRecordFindResource returns the DNS resource with the name and domain of the record,
+// or nil if there is none.
+func resourceDNSRecordFindResource(d *schema.ResourceData, m interface{}) (*entity.DNSResource, error) {
+	mo := maasClient(m)
+	resources, err := gmaw.NewDNSResources(mo).Get()
+	if err != nil {
+		return nil, err
+	}
+	fqdn := d.Get("name").(string) + "." + d.Get("domain").(string)
+	for idx := range resources {
+		if resources[idx].FQDN == fqdn {
+			return &resources[idx], nil
+		}
+	}
+	return nil, nil
+}
+
+// resourceDNSRecordAddresses returns the addresses of the DNS resource for the record type <rrtype>,
+// ie its IPv4 addresses for A records and its IPv6 addresses for AAAA records.
+func resourceDNSRecordAddresses(res *entity.DNSResource, rrtype string) []string {
+	addrs := make([]string, 0, len(res.IPAddresses))
+	for _, addr := range res.IPAddresses {
+		if (rrtype == "A") == (addr.IP.To4() != nil) {
+			addrs = append(addrs, addr.IP.String())
+		}
+	}
+	return addrs
+}
+
+// resourceDNSRecordHasAddress returns true if <addr> is one of <addrs>, comparing them as IP addresses.
+func resourceDNSRecordHasAddress(addrs []string, addr string) bool {
+	ip := net.ParseIP(addr)
+	for _, a := range addrs {
+		if ip != nil && ip.Equal(net.ParseIP(a)) {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceDNSRecordReplaceAddress returns all the addresses of the DNS resource with <oldAddr> removed
+// and <newAddr> added, leaving the addresses of the other records as they are. Either may be empty.
+func resourceDNSRecordReplaceAddress(res *entity.DNSResource, oldAddr, newAddr string) []string {
+	addrs := make([]string, 0, len(res.IPAddresses)+1)
+	for _, addr := range res.IPAddresses {
+		a := addr.IP.String()
+		if !resourceDNSRecordHasAddress([]string{oldAddr, newAddr}, a) {
+			addrs = append(addrs, a)
+		}
+	}
+	if newAddr != "" {
+		addrs = append(addrs, newAddr)
+	}
+	return addrs
+}
+
+// resourceDNSRecordImport imports a record from an ID in the form <type>:<id>, eg CNAME:12,
+// because the type determines which MaaS endpoint the ID belongs to.
+func resourceDNSRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	idx := strings.Index(d.Id(), ":")
+	if idx < 0 {
+		return nil, fmt.Errorf("the ID must be in the form <type>:<id> (got '%s')", d.Id())
+	}
+	if err := d.Set("type", strings.ToUpper(d.Id()[:idx])); err != nil {
+		return nil, err
+	}
+	d.SetId(d.Id()[idx+1:])
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceDNSRecordIsAddress returns true if the record is an address record (A or AAAA).
+func resourceDNSRecordIsAddress(d *schema.ResourceData) bool {
+	rrtype := d.Get("type").(string)
+	return rrtype == "A" || rrtype == "AAAA"
+}
+
+// resourceDNSRecordValidate checks that the data of an address record is an IP address of the right family.
+func resourceDNSRecordValidate(d *schema.ResourceData) error {
+	if !resourceDNSRecordIsAddress(d) {
+		return nil
+	}
+	rrtype, data := d.Get("type").(string), d.Get("data").(string)
+	ip := net.ParseIP(data)
+	if ip == nil || (rrtype == "A") != (ip.To4() != nil) {
+		version := "4"
+		if rrtype == "AAAA" {
+			version = "6"
+		}
+		return fmt.Errorf("the data of an %s record must be an IPv%s address (got '%s')", rrtype, version, data)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package provider_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jarcoal/httpmock"
+
+	. "github.com/roblox/terraform-provider-maas/internal/provider"
+)
+
+func TestResourceDNSRecord_SharedAddresses(t *testing.T) {
+	client := testClient(t)
+	defer httpmock.DeactivateAndReset()
+	res := Provider().(*schema.Provider).ResourcesMap["maas_dns_record"]
+	resourcesURL := testAPIURL + "/api/2.0/dnsresources/"
+	resourceURL := resourcesURL + "1/"
+
+	// A single DNS resource, holding the addresses of every record named www
+	var addrs []string
+	exists := false
+	render := func() (*http.Response, error) {
+		ips := make([]map[string]string, 0, len(addrs))
+		for _, addr := range addrs {
+			ips = append(ips, map[string]string{"ip": addr})
+		}
+		return httpmock.NewJsonResponse(http.StatusOK, map[string]interface{}{
+			"id":           1,
+			"fqdn":         "www.maas",
+			"ip_addresses": ips,
+			"resource_uri": "/MAAS/api/2.0/dnsresources/1/",
+		})
+	}
+	setAddresses := func(req *http.Request) (*http.Response, error) {
+		if err := req.ParseForm(); err != nil {
+			return nil, err
+		}
+		addrs = strings.Fields(req.Form.Get("ip_addresses"))
+		exists = true
+		return render()
+	}
+	httpmock.RegisterResponder("GET", resourcesURL, func(*http.Request) (*http.Response, error) {
+		if !exists {
+			return httpmock.NewStringResponse(http.StatusOK, "[]"), nil
+		}
+		resp, err := render()
+		if err != nil {
+			return nil, err
+		}
+		var body json.RawMessage
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			return nil, err
+		}
+		return httpmock.NewJsonResponse(http.StatusOK, []json.RawMessage{body})
+	})
+	httpmock.RegisterResponder("POST", resourcesURL, setAddresses)
+	httpmock.RegisterResponder("PUT", resourceURL, setAddresses)
+	httpmock.RegisterResponder("GET", resourceURL, func(*http.Request) (*http.Response, error) {
+		return render()
+	})
+	httpmock.RegisterResponder("DELETE", resourceURL, func(*http.Request) (*http.Response, error) {
+		addrs, exists = nil, false
+		return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+	})
+
+	records := make([]*schema.ResourceData, 0, 3)
+	for _, rec := range [][2]string{{"A", "10.0.0.1"}, {"AAAA", "2001:db8::1"}, {"A", "10.0.0.2"}} {
+		d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+			"type":   rec[0],
+			"name":   "www",
+			"domain": "maas",
+			"data":   rec[1],
+		})
+		if err := res.Create(d, client); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(rec[1], d.Get("data")); diff != "" {
+			t.Errorf("data mismatch (-want +got):\n%s", diff)
+		}
+		records = append(records, d)
+	}
+	if diff := cmp.Diff([]string{"10.0.0.1", "2001:db8::1", "10.0.0.2"}, addrs); diff != "" {
+		t.Errorf("addresses mismatch after create (-want +got):\n%s", diff)
+	}
+	info := httpmock.GetCallCountInfo()
+	if info["POST "+resourcesURL] != 1 || info["PUT "+resourceURL] != 2 {
+		t.Errorf("Expected 1 POST and 2 PUTs, got %v", info)
+	}
+
+	// Each record only removes its own address, and the last one deletes the DNS resource
+	if err := res.Delete(records[1], client); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"10.0.0.1", "10.0.0.2"}, addrs); diff != "" {
+		t.Errorf("addresses mismatch after delete (-want +got):\n%s", diff)
+	}
+	if err := res.Delete(records[0], client); err != nil {
+		t.Fatal(err)
+	}
+	if err := res.Delete(records[2], client); err != nil {
+		t.Fatal(err)
+	}
+	if exists {
+		t.Errorf("Expected the DNS resource to be deleted, got addresses %v", addrs)
+	}
+}
@@ -2,7 +2,6 @@ package provider
 
 import (
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/juju/gomaasapi"
 	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
 	"github.com/roblox/terraform-provider-maas/pkg/maas"
 )
@@ -15,17 +14,109 @@ func resourceInstance() *schema.Resource {
 		Update: resourceInstanceUpdate,
 		Delete: resourceInstanceDelete,
 
+		CustomizeDiff: func(d *schema.ResourceDiff, m interface{}) error {
+			return InstanceLockCustomizeDiff(d)
+		},
+
 		Schema: map[string]*schema.Schema{
 			"address": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"lock": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"locked": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"locked_by_terraform": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"pool": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"osystem": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"distro_series": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"hwe_kernel": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"license_key": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"kernel_options": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"user_data": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				StateFunc: (&Instance{}).UserDataStateFunc,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"install_kvm": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"install_rackd": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"ephemeral_deploy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"enable_hw_sync": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
 		},
 	}
 }
 
 func resourceInstanceCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*gomaasapi.MAASObject)
+	client := maasClient(m)
 	var instance Instance
 
 	// Start by allocating the machine
@@ -45,21 +136,27 @@ func resourceInstanceCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	// Get node params and set them along with the deploy
-	dp := instance.FromMachine(machineManager.Current()).DeployParams()
+	// Deploy the machine with the options in the configuration
+	dp := NewInstance(d).DeployParams()
 	if err := machineManager.Deploy(dp); err != nil {
 		machinesManager.Release([]string{machineManager.SystemID()}, "The deploy has broke") // nolint
+		return err
+	}
+
+	// Move the machine to its zone, pool and domain, if necessary
+	if err := resourceInstanceReconcileMetadata(d, machineManager); err != nil {
+		return err
 	}
 
 	// Lock the machine, if necessary
-	if instance.Lock {
-		machineManager.Lock("Locked by Terraform") // nolint
+	if err := ReconcileInstanceLock(d, machineManager); err != nil {
+		return err
 	}
 	return resourceInstanceRead(d, m)
 }
 
 func resourceInstanceRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*gomaasapi.MAASObject)
+	client := maasClient(m)
 	var instance Instance
 
 	machineManager, err := maas.NewMachineManager(d.Id(), gmaw.NewMachine(client))
@@ -69,23 +166,79 @@ func resourceInstanceRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	instance.FromMachine(machineManager.Current()).UpdateState(d)
-	return nil
+	if err := d.Set("zone", machineManager.Current().Zone.Name); err != nil {
+		return err
+	}
+	if err := d.Set("pool", machineManager.Current().Pool.Name); err != nil {
+		return err
+	}
+	if err := d.Set("domain", machineManager.Current().Domain.Name); err != nil {
+		return err
+	}
+	return ReadInstanceLock(d, machineManager.Current())
 }
 
 func resourceInstanceUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*gomaasapi.MAASObject)
+	client := maasClient(m)
 	machineManager, err := maas.NewMachineManager(d.Id(), gmaw.NewMachine(client))
 	if err != nil {
 		return err
 	}
 
-	// Lock the machine, if necessary
-	if NewInstance(d).Lock {
-		machineManager.Lock("Locked by Terraform") // nolint
+	// Move the machine between zones, pools and domains in place
+	if err := resourceInstanceReconcileMetadata(d, machineManager); err != nil {
+		return err
+	}
+
+	// Lock or unlock the machine, if necessary
+	if err := ReconcileInstanceLock(d, machineManager); err != nil {
+		return err
 	}
 	return resourceInstanceRead(d, m)
 }
 
 func resourceInstanceDelete(d *schema.ResourceData, m interface{}) error {
+	client := maasClient(m)
+	machineManager, err := maas.NewMachineManager(d.Id(), gmaw.NewMachine(client))
+	if err != nil {
+		return err
+	}
+
+	// A locked machine cannot be released, and only a lock placed by Terraform is Terraform's to remove
+	if err := UnlockInstance(d, machineManager); err != nil {
+		return err
+	}
+
+	machinesManager := maas.NewMachinesManager(gmaw.NewMachines(client))
+	if err := machinesManager.Release([]string{machineManager.SystemID()}, "Released by Terraform"); err != nil {
+		return err
+	}
+
+	// The machine no longer boots with the kernel options of the deploy
+	if _, ok := d.GetOk("kernel_options"); ok {
+		if err := gmaw.NewMachine(client).UntagKernelOptions(d.Id()); err != nil {
+			return err
+		}
+	}
+	d.SetId("")
 	return nil
 }
+
+// resourceInstanceReconcileMetadata moves the machine to the zone, pool and domain in the
+// configuration. A zone, pool or domain that is not configured is left as it is.
+func resourceInstanceReconcileMetadata(d *schema.ResourceData, machineManager *maas.MachineManager) error {
+	var params maas.MachineUpdateParams
+	if zone := d.Get("zone").(string); zone != "" && zone != machineManager.Current().Zone.Name {
+		params.Zone = &zone
+	}
+	if pool := d.Get("pool").(string); pool != "" && pool != machineManager.Current().Pool.Name {
+		params.Pool = &pool
+	}
+	if domain := d.Get("domain").(string); domain != "" && domain != machineManager.Current().Domain.Name {
+		params.Domain = &domain
+	}
+	if params == (maas.MachineUpdateParams{}) {
+		return nil
+	}
+	return machineManager.Put(params)
+}
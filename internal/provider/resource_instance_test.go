@@ -0,0 +1,159 @@
+package provider_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jarcoal/httpmock"
+
+	. "github.com/roblox/terraform-provider-maas/internal/provider"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+)
+
+const testAPIURL = "http://localhost:5240/MAAS"
+
+// testClient activates httpmock and returns a client for its API URL
+func testClient(t *testing.T) interface{} {
+	httpmock.Activate()
+	client, err := gmaw.GetClient(testAPIURL, "some:secret:key", "2.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestResourceInstanceUpdate_Lock(t *testing.T) {
+	client := testClient(t)
+	defer httpmock.DeactivateAndReset()
+	res := Provider().(*schema.Provider).ResourcesMap["maas_instance"]
+	machineURL := testAPIURL + "/api/2.0/machines/abc123/"
+
+	tests := []struct {
+		name              string
+		lock              bool
+		locked            bool
+		lockedByTerraform bool
+		op                string
+		wantByTerraform   bool
+	}{
+		{"lock", true, false, false, "lock", true},
+		{"unlock own lock", false, true, true, "unlock", false},
+		{"keep foreign lock", false, true, false, "", false},
+		{"already locked", true, true, false, "", false},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			httpmock.Reset()
+			locked := tc.locked
+			machine := func() string {
+				return fmt.Sprintf(`{"system_id": "abc123", "resource_uri": "/MAAS/api/2.0/machines/abc123/",
+					"locked": %t}`, locked)
+			}
+			httpmock.RegisterResponder("GET", machineURL, func(*http.Request) (*http.Response, error) {
+				return httpmock.NewStringResponse(http.StatusOK, machine()), nil
+			})
+			for _, op := range []string{"lock", "unlock"} {
+				op := op
+				httpmock.RegisterResponder("POST", machineURL+"?op="+op,
+					func(*http.Request) (*http.Response, error) {
+						locked = op == "lock"
+						return httpmock.NewStringResponse(http.StatusOK, machine()), nil
+					})
+			}
+
+			d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"address": "10.0.0.1", "lock": tc.lock})
+			d.SetId("abc123")
+			if err := d.Set("locked_by_terraform", tc.lockedByTerraform); err != nil {
+				t.Fatal(err)
+			}
+			if err := res.Update(d, client); err != nil {
+				t.Fatal(err)
+			}
+
+			info := httpmock.GetCallCountInfo()
+			for _, op := range []string{"lock", "unlock"} {
+				want := 0
+				if op == tc.op {
+					want = 1
+				}
+				if n := info["POST "+machineURL+"?op="+op]; n != want {
+					t.Errorf("%s called %d times, want %d", op, n, want)
+				}
+			}
+			if got := d.Get("locked_by_terraform").(bool); got != tc.wantByTerraform {
+				t.Errorf("locked_by_terraform = %t, want %t", got, tc.wantByTerraform)
+			}
+
+			// The lock attribute is the configuration, and locked is the state of the machine
+			if got := d.Get("lock").(bool); got != tc.lock {
+				t.Errorf("lock = %t, want %t", got, tc.lock)
+			}
+			if got := d.Get("locked").(bool); got != locked {
+				t.Errorf("locked = %t, want %t", got, locked)
+			}
+		})
+	}
+}
+
+func TestResourceInstanceDelete_ForeignLock(t *testing.T) {
+	client := testClient(t)
+	defer httpmock.DeactivateAndReset()
+	res := Provider().(*schema.Provider).ResourcesMap["maas_instance"]
+	httpmock.RegisterResponder("GET", testAPIURL+"/api/2.0/machines/abc123/",
+		httpmock.NewStringResponder(http.StatusOK,
+			`{"system_id": "abc123", "resource_uri": "/MAAS/api/2.0/machines/abc123/", "locked": true}`))
+
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"address": "10.0.0.1"})
+	d.SetId("abc123")
+	if err := res.Delete(d, client); err == nil {
+		t.Fatal("Expected an error when releasing a machine locked outside of Terraform")
+	}
+	if n := httpmock.GetTotalCallCount(); n != 1 {
+		t.Errorf("%d requests sent, want only the GET of the machine", n)
+	}
+}
+
+func TestResourceInstanceCreate_Deploy(t *testing.T) {
+	client := testClient(t)
+	defer httpmock.DeactivateAndReset()
+	res := Provider().(*schema.Provider).ResourcesMap["maas_instance"]
+	machinesURL := testAPIURL + "/api/2.0/machines/"
+	machine := `{"system_id": "abc123", "resource_uri": "/MAAS/api/2.0/machines/abc123/"}`
+	httpmock.RegisterResponder("POST", machinesURL+"?op=allocate", httpmock.NewStringResponder(http.StatusOK, machine))
+	httpmock.RegisterResponder("GET", machinesURL+"abc123/", httpmock.NewStringResponder(http.StatusOK, machine))
+	httpmock.RegisterResponder("POST", machinesURL+"?op=release", httpmock.NewStringResponder(http.StatusOK, "[]"))
+
+	// The deploy takes the options in the configuration, and its error is returned
+	var deployed url.Values
+	httpmock.RegisterResponder("POST", machinesURL+"abc123/?op=deploy",
+		func(req *http.Request) (*http.Response, error) {
+			if err := req.ParseForm(); err != nil {
+				return nil, err
+			}
+			deployed = req.Form
+			return httpmock.NewStringResponse(http.StatusConflict, "No image available"), nil
+		})
+
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"address":       "10.0.0.1",
+		"osystem":       "ubuntu",
+		"distro_series": "focal",
+		"hwe_kernel":    "hwe-20.04",
+	})
+	if err := res.Create(d, client); err == nil || !strings.Contains(err.Error(), "No image available") {
+		t.Fatalf("Expected the deploy error, got %v", err)
+	}
+	want := url.Values{"op": {"deploy"}, "osystem": {"ubuntu"}, "distro_series": {"focal"}, "hwe_kernel": {"hwe-20.04"}}
+	if diff := cmp.Diff(want, deployed); diff != "" {
+		t.Errorf("deploy parameters mismatch (-want +got):\n%s", diff)
+	}
+	if n := httpmock.GetCallCountInfo()["POST "+machinesURL+"?op=release"]; n != 1 {
+		t.Errorf("machine released %d times, want 1", n)
+	}
+}
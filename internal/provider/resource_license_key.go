@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+)
+
+// ResourceLicenseKey manages the license key of an OS release, such as a Windows Server release,
+// which MaaS uses when deploying that release. Its ID is <osystem>/<distro_series>.
+func ResourceLicenseKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLicenseKeyCreate,
+		Read:   resourceLicenseKeyRead,
+		Update: resourceLicenseKeyUpdate,
+		Delete: resourceLicenseKeyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"osystem": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"distro_series": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"license_key": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceLicenseKeyCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	key, err := gmaw.NewLicenseKeys(mo).Post(&params.LicenseKey{
+		OSystem:      d.Get("osystem").(string),
+		DistroSeries: d.Get("distro_series").(string),
+		LicenseKey:   d.Get("license_key").(string),
+	})
+	if err != nil {
+		return err
+	}
+	d.SetId(key.OSystem + "/" + key.DistroSeries)
+	return resourceLicenseKeyRead(d, m)
+}
+
+func resourceLicenseKeyRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	osystem, distroSeries, err := resourceLicenseKeyID(d)
+	if err != nil {
+		return err
+	}
+	key, err := gmaw.NewLicenseKey(mo).Get(osystem, distroSeries)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	tfstate := map[string]interface{}{
+		"osystem":       key.OSystem,
+		"distro_series": key.DistroSeries,
+		"license_key":   key.LicenseKey,
+	}
+	for k, v := range tfstate {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceLicenseKeyUpdate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	osystem, distroSeries, err := resourceLicenseKeyID(d)
+	if err != nil {
+		return err
+	}
+	p := &params.LicenseKey{LicenseKey: d.Get("license_key").(string)}
+	if _, err := gmaw.NewLicenseKey(mo).Put(osystem, distroSeries, p); err != nil {
+		return err
+	}
+	return resourceLicenseKeyRead(d, m)
+}
+
+func resourceLicenseKeyDelete(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	osystem, distroSeries, err := resourceLicenseKeyID(d)
+	if err != nil {
+		return err
+	}
+	if err := gmaw.NewLicenseKey(mo).Delete(osystem, distroSeries); err != nil && !isNotFound(err) {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceLicenseKeyID returns the OS and release of the license key from its ID.
+func resourceLicenseKeyID(d *schema.ResourceData) (osystem, distroSeries string, err error) {
+	idx := strings.Index(d.Id(), "/")
+	if idx <= 0 || idx == len(d.Id())-1 {
+		return "", "", fmt.Errorf("the ID must be in the form <osystem>/<distro_series> (got '%s')", d.Id())
+	}
+	return d.Id()[:idx], d.Id()[idx+1:], nil
+}
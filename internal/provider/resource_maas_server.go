@@ -1,15 +1,41 @@
 package provider
 
 import (
-	"strings"
+	"fmt"
+	"sort"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/juju/gomaasapi"
 	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
 )
 
-// ResourceServer manages global MaaS configuration options ala the MaaS Server endpoint
+// serverConfigKeys are the global configuration keys managed by the maas_server resource
+var serverConfigKeys = map[string]ServerConfigKind{
+	"ntp_servers":                 ServerConfigList,
+	"upstream_dns":                ServerConfigList,
+	"dnssec_validation":           ServerConfigString,
+	"default_distro_series":       ServerConfigString,
+	"default_min_hwe_kernel":      ServerConfigString,
+	"commissioning_distro_series": ServerConfigString,
+	"http_proxy":                  ServerConfigString,
+	"enable_http_proxy":           ServerConfigBool,
+	"kernel_opts":                 ServerConfigString,
+	"maas_name":                   ServerConfigString,
+	"enable_third_party_drivers":  ServerConfigBool,
+	"default_storage_layout":      ServerConfigString,
+	"network_discovery":           ServerConfigString,
+	"active_discovery_interval":   ServerConfigInt,
+}
+
+// serverConfigChoices are the values accepted by the configuration keys that take one of a few values
+var serverConfigChoices = map[string][]string{
+	"dnssec_validation":      {"auto", "yes", "no"},
+	"default_storage_layout": {"bcache", "blank", "flat", "lvm", "vmfs6"},
+	"network_discovery":      {"enabled", "disabled"},
+}
+
+// ResourceServer manages global MaaS configuration options ala the MaaS Server endpoint.
+// Every key is optional; a key that is not configured is left as it is in MaaS.
 func ResourceServer() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceServerCreate,
@@ -17,42 +43,97 @@ func ResourceServer() *schema.Resource {
 		Update: resourceServerUpdate,
 		Delete: resourceServerDelete,
 
-		Schema: map[string]*schema.Schema{
-			"ntp_servers": &schema.Schema{
-				Type:     schema.TypeList,
-				Required: true,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-			},
+		Schema: resourceServerSchema(),
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
 		},
 	}
 }
 
-func resourceServerCreate(d *schema.ResourceData, m interface{}) (err error) {
-	err = resourceServerUpdate(d, m)
-	if err == nil {
-		d.SetId(time.Now().Format(time.RFC3339))
+// resourceServerSchema returns a schema with an optional attribute for each configuration key.
+func resourceServerSchema() map[string]*schema.Schema {
+	res := make(map[string]*schema.Schema, len(serverConfigKeys))
+	for key, kind := range serverConfigKeys {
+		s := &schema.Schema{
+			Optional: true,
+			Computed: true,
+		}
+		switch kind {
+		case ServerConfigBool:
+			s.Type = schema.TypeBool
+		case ServerConfigInt:
+			s.Type = schema.TypeInt
+		case ServerConfigList:
+			s.Type = schema.TypeList
+			s.Elem = &schema.Schema{Type: schema.TypeString}
+		default:
+			s.Type = schema.TypeString
+		}
+		if choices, ok := serverConfigChoices[key]; ok {
+			s.ValidateFunc = resourceServerValidateChoice(choices)
+		}
+		res[key] = s
 	}
-	return
+	return res
+}
+
+// resourceServerValidateChoice returns a ValidateFunc that accepts one of choices.
+func resourceServerValidateChoice(choices []string) schema.SchemaValidateFunc {
+	return func(val interface{}, key string) (warns []string, errs []error) {
+		v := val.(string)
+		for _, choice := range choices {
+			if v == choice {
+				return
+			}
+		}
+		errs = append(errs, fmt.Errorf("%q must be one of %q (got '%s')", key, choices, v))
+		return
+	}
+}
+
+func resourceServerCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	client := gmaw.NewMAASServer(mo)
+	for _, key := range resourceServerKeys() {
+		if val, ok := d.GetOkExists(key); ok {
+			if err := client.Post(key, serverConfigKeys[key].Format(val)); err != nil {
+				return err
+			}
+		}
+	}
+	d.SetId(time.Now().Format(time.RFC3339))
+	return resourceServerRead(d, m)
 }
 
 func resourceServerRead(d *schema.ResourceData, m interface{}) error {
-	mo := m.(*gomaasapi.MAASObject)
+	mo := maasClient(m)
 	client := gmaw.NewMAASServer(mo)
-	res, err := client.Get("ntp_servers")
-	if err == nil {
-		err = d.Set("ntp_servers", strings.Split(res, ","))
+	for _, key := range resourceServerKeys() {
+		res, err := client.Get(key)
+		if err != nil {
+			return err
+		}
+		val, err := serverConfigKeys[key].Parse(res)
+		if err != nil {
+			return fmt.Errorf("%s: %s", key, err)
+		}
+		if err := d.Set(key, val); err != nil {
+			return err
+		}
 	}
-	return err
+	return nil
 }
 
 func resourceServerUpdate(d *schema.ResourceData, m interface{}) error {
-	mo := m.(*gomaasapi.MAASObject)
+	mo := maasClient(m)
 	client := gmaw.NewMAASServer(mo)
-	val := d.Get("ntp_servers").([]string)
-	if err := client.Post("ntp_servers", strings.Join(val, ",")); err != nil {
-		return err
+	for _, key := range resourceServerKeys() {
+		if d.HasChange(key) {
+			if err := client.Post(key, serverConfigKeys[key].Format(d.Get(key))); err != nil {
+				return err
+			}
+		}
 	}
 	return resourceServerRead(d, m)
 }
@@ -61,3 +142,14 @@ func resourceServerDelete(d *schema.ResourceData, m interface{}) error {
 	d.SetId("")
 	return nil
 }
+
+// resourceServerKeys returns the configuration keys in a stable order, so they are
+// always set in the same order.
+func resourceServerKeys() []string {
+	keys := make([]string, 0, len(serverConfigKeys))
+	for key := range serverConfigKeys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
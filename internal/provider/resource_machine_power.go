@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity/node"
+)
+
+// ResourceMachinePower manages the power state of a deployed MaaS Machine
+func ResourceMachinePower() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMachinePowerCreate,
+		Read:   resourceMachinePowerRead,
+		Update: resourceMachinePowerUpdate,
+		Delete: resourceMachinePowerDelete,
+
+		Schema: map[string]*schema.Schema{
+			"system_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"power_state": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					if !(v == "on" || v == "off") {
+						errs = append(errs, fmt.Errorf("%q must be 'on' or 'off' (got '%s')", key, v))
+					}
+					return
+				},
+			},
+			"stop_mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "hard",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					if !(v == "soft" || v == "hard") {
+						errs = append(errs, fmt.Errorf("%q must be 'soft' or 'hard' (got '%s')", key, v))
+					}
+					return
+				},
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute), // nolint: gomnd
+			Update: schema.DefaultTimeout(10 * time.Minute), // nolint: gomnd
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceMachinePowerCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	machineManager, err := maas.NewMachineManager(d.Get("system_id").(string), gmaw.NewMachine(mo))
+	if err != nil {
+		return err
+	}
+	if status := node.Status(machineManager.Current().Status); status != node.StatusDeployed {
+		return fmt.Errorf("machine %s must be deployed to manage its power state (status is %d)",
+			machineManager.SystemID(), status)
+	}
+
+	if err := resourceMachinePowerConverge(d, machineManager, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+	d.SetId(machineManager.SystemID())
+	return resourceMachinePowerRead(d, m)
+}
+
+func resourceMachinePowerRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	machineManager, err := maas.NewMachineManager(d.Id(), gmaw.NewMachine(mo))
+	if err != nil {
+		return err
+	}
+
+	// Query the BMC rather than trusting the last known power state,
+	// so a change made outside of Terraform is reported as drift.
+	state, err := machineManager.QueryPowerState()
+	if err != nil {
+		return err
+	}
+	if err := d.Set("system_id", machineManager.SystemID()); err != nil {
+		return err
+	}
+	return d.Set("power_state", state)
+}
+
+func resourceMachinePowerUpdate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	machineManager, err := maas.NewMachineManager(d.Id(), gmaw.NewMachine(mo))
+	if err != nil {
+		return err
+	}
+	if err := resourceMachinePowerConverge(d, machineManager, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+	return resourceMachinePowerRead(d, m)
+}
+
+// resourceMachinePowerDelete stops managing the power state, leaving the machine as it is.
+func resourceMachinePowerDelete(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// resourceMachinePowerConverge powers the machine on or off to match the desired
+// power_state, then waits for the BMC to report the new state.
+func resourceMachinePowerConverge(d *schema.ResourceData, machineManager *maas.MachineManager,
+	timeout time.Duration) error {
+	want := d.Get("power_state").(string)
+	got, err := machineManager.QueryPowerState()
+	if err != nil || got == want {
+		return err
+	}
+
+	if want == "on" {
+		err = machineManager.PowerOn(maas.MachinePowerOnParams{Comment: "Powered on by Terraform"})
+	} else {
+		err = machineManager.PowerOff(maas.MachinePowerOffParams{
+			StopMode: d.Get("stop_mode").(string),
+			Comment:  "Powered off by Terraform",
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{got, "unknown"},
+		Target:  []string{want},
+		Refresh: func() (interface{}, string, error) {
+			state, err := machineManager.QueryPowerState()
+			return state, state, err
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second, // nolint: gomnd
+		MinTimeout: 3 * time.Second, // nolint: gomnd
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for machine %s to power %s: %s", machineManager.SystemID(), want, err)
+	}
+	return nil
+}
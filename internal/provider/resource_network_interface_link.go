@@ -82,7 +82,7 @@ func ResourceNetworkInterfaceLink() *schema.Resource {
 }
 
 func resourceNetworkInterfaceLinkCreate(d *schema.ResourceData, m interface{}) (err error) {
-	ifc := bridge.NewNetworkInterface(m)
+	ifc := bridge.NewNetworkInterface(maasClient(m))
 	sch := tfschema.NewNetworkInterfaceLink(d)
 	if err = ifc.LinkSubnet(sch); err != nil {
 		return
@@ -94,7 +94,7 @@ func resourceNetworkInterfaceLinkCreate(d *schema.ResourceData, m interface{}) (
 }
 
 func resourceNetworkInterfaceLinkRead(d *schema.ResourceData, m interface{}) (err error) {
-	ifc := bridge.NewNetworkInterface(m)
+	ifc := bridge.NewNetworkInterface(maasClient(m))
 	sch := tfschema.NewNetworkInterfaceLink(d)
 	if err = ifc.ReadLink(sch); err == nil {
 		err = sch.UpdateResource(d)
@@ -103,7 +103,7 @@ func resourceNetworkInterfaceLinkRead(d *schema.ResourceData, m interface{}) (er
 }
 
 func resourceNetworkInterfaceLinkDelete(d *schema.ResourceData, m interface{}) (err error) {
-	ifc := bridge.NewNetworkInterface(m)
+	ifc := bridge.NewNetworkInterface(maasClient(m))
 	sch := tfschema.NewNetworkInterfaceLink(d)
 	if err = ifc.UnlinkSubnet(sch); err == nil {
 		d.SetId("")
@@ -65,7 +65,7 @@ func ResourceNetworkInterfacePhysical() *schema.Resource {
 }
 
 func resourceNetworkInterfacePhysicalCreate(d *schema.ResourceData, m interface{}) error {
-	ifc := bridge.NewNetworkInterface(m)
+	ifc := bridge.NewNetworkInterface(maasClient(m))
 	sch := tfschema.NewNetworkInterfacePhysical(d)
 	if err := ifc.Create(sch); err != nil {
 		return err
@@ -77,7 +77,7 @@ func resourceNetworkInterfacePhysicalCreate(d *schema.ResourceData, m interface{
 }
 
 func resourceNetworkInterfacePhysicalRead(d *schema.ResourceData, m interface{}) (err error) {
-	ifc := bridge.NewNetworkInterface(m)
+	ifc := bridge.NewNetworkInterface(maasClient(m))
 	sch := tfschema.NewNetworkInterfacePhysical(d)
 	if err = ifc.ReadTo(sch); err == nil {
 		err = sch.UpdateResource(d)
@@ -86,7 +86,7 @@ func resourceNetworkInterfacePhysicalRead(d *schema.ResourceData, m interface{})
 }
 
 func resourceNetworkInterfacePhysicalUpdate(d *schema.ResourceData, m interface{}) error {
-	ifc := bridge.NewNetworkInterface(m)
+	ifc := bridge.NewNetworkInterface(maasClient(m))
 	sch := tfschema.NewNetworkInterfacePhysical(d)
 	if err := ifc.UpdateFrom(sch); err != nil {
 		return err
@@ -95,7 +95,7 @@ func resourceNetworkInterfacePhysicalUpdate(d *schema.ResourceData, m interface{
 }
 
 func resourceNetworkInterfacePhysicalDelete(d *schema.ResourceData, m interface{}) (err error) {
-	ifc := bridge.NewNetworkInterface(m)
+	ifc := bridge.NewNetworkInterface(maasClient(m))
 	sch := tfschema.NewNetworkInterfacePhysical(d)
 	if err = ifc.Delete(sch); err == nil {
 		d.SetId("")
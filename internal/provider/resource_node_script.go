@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+)
+
+// ResourceNodeScript manages a MaaS Script, ie a commissioning or testing script that
+// is run on machines by name or tag, such as with the commissioning_scripts and
+// testing_scripts parameters of the commission operation. Each change to the body of
+// the script adds a revision to its history in MaaS.
+func ResourceNodeScript() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNodeScriptCreate,
+		Read:   resourceNodeScriptRead,
+		Update: resourceNodeScriptUpdate,
+		Delete: resourceNodeScriptDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"script": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"title": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "testing",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					switch v := val.(string); v {
+					case "commissioning", "testing":
+					default:
+						errs = append(errs, fmt.Errorf("%q must be 'commissioning' or 'testing' (got '%s')", key, v))
+					}
+					return
+				},
+			},
+			"hardware_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "node",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					switch v := val.(string); v {
+					case "node", "cpu", "memory", "storage", "network":
+					default:
+						errs = append(errs, fmt.Errorf("%q must be 'node', 'cpu', 'memory', 'storage' or 'network' (got '%s')",
+							key, v))
+					}
+					return
+				},
+			},
+			"parallel": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "disabled",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					switch v := val.(string); v {
+					case "disabled", "instance", "any":
+					default:
+						errs = append(errs, fmt.Errorf("%q must be 'disabled', 'instance' or 'any' (got '%s')", key, v))
+					}
+					return
+				},
+			},
+			"timeout": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					if v := val.(int); v < 0 {
+						errs = append(errs, fmt.Errorf("%q must not be negative (got %d)", key, v))
+					}
+					return
+				},
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"for_hardware": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"destructive": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"may_reboot": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"revision": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceNodeScriptCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	p := resourceNodeScriptParams(d)
+	p.Script = []byte(d.Get("script").(string))
+	script, err := gmaw.NewScripts(mo).Post(p)
+	if err != nil {
+		return err
+	}
+	d.SetId(script.Name)
+	return resourceNodeScriptRead(d, m)
+}
+
+func resourceNodeScriptRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	script, err := gmaw.NewScript(mo).Get(d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	timeout, err := ParseScriptTimeout(script.Timeout)
+	if err != nil {
+		return err
+	}
+
+	tfstate := map[string]interface{}{
+		"name":          script.Name,
+		"title":         script.Title,
+		"description":   script.Description,
+		"type":          scriptTypes[script.Type],
+		"hardware_type": scriptHardwareTypes[script.HardwareType],
+		"parallel":      scriptParallel[script.Parallel],
+		"timeout":       timeout,
+		"tags":          script.Tags,
+		"for_hardware":  script.ForHardware,
+		"destructive":   script.Destructive,
+		"may_reboot":    script.MayReboot,
+	}
+	// The history is newest first
+	if len(script.History) > 0 {
+		tfstate["script"] = string(script.History[0].Data)
+		tfstate["revision"] = script.History[0].ID
+	}
+	for k, v := range tfstate {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceNodeScriptUpdate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	p := resourceNodeScriptParams(d)
+	// Only upload the script when it changed, so that no empty revisions are added
+	if d.HasChange("script") {
+		p.Script = []byte(d.Get("script").(string))
+	}
+	if _, err := gmaw.NewScript(mo).Put(d.Id(), p); err != nil {
+		return err
+	}
+	return resourceNodeScriptRead(d, m)
+}
+
+func resourceNodeScriptDelete(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	if err := gmaw.NewScript(mo).Delete(d.Id()); err != nil && !isNotFound(err) {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceNodeScriptParams returns the parameters for creating or updating a script from the
+// resource data, without the body of the script. They take precedence over the metadata
+// embedded in the script.
+func resourceNodeScriptParams(d *schema.ResourceData) *params.Script {
+	return &params.Script{
+		Name:         d.Get("name").(string),
+		Title:        d.Get("title").(string),
+		Description:  d.Get("description").(string),
+		Type:         d.Get("type").(string),
+		HardwareType: d.Get("hardware_type").(string),
+		Parallel:     d.Get("parallel").(string),
+		Timeout:      d.Get("timeout").(int),
+		Tags:         setToStrings(d.Get("tags").(*schema.Set)),
+		ForHardware:  setToStrings(d.Get("for_hardware").(*schema.Set)),
+		Destructive:  d.Get("destructive").(bool),
+		MayReboot:    d.Get("may_reboot").(bool),
+	}
+}
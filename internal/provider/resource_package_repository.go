@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+)
+
+// ResourcePackageRepository manages a MaaS Package Repository, ie an APT repository or PPA
+// that MaaS configures on the machines it deploys, and that curtin installs packages from.
+func ResourcePackageRepository() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePackageRepositoryCreate,
+		Read:   resourcePackageRepositoryRead,
+		Update: resourcePackageRepositoryUpdate,
+		Delete: resourcePackageRepositoryDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"url": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					if err := ValidatePackageRepositoryURL(val.(string)); err != nil {
+						errs = append(errs, fmt.Errorf("%q: %s", key, err))
+					}
+					return
+				},
+			},
+			"distributions": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"components": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"disabled_pockets": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+						switch v := val.(string); v {
+						case "updates", "security", "backports":
+						default:
+							errs = append(errs, fmt.Errorf("%q must be 'updates', 'security' or 'backports' (got '%s')", key, v))
+						}
+						return
+					},
+				},
+			},
+			"arches": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressSurroundingSpace,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					if err := ValidatePackageRepositoryKey(val.(string)); err != nil {
+						errs = append(errs, fmt.Errorf("%q: %s", key, err))
+					}
+					return
+				},
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourcePackageRepositoryCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	repo, err := gmaw.NewPackageRepositories(mo).Post(resourcePackageRepositoryParams(d))
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.Itoa(repo.ID))
+	return resourcePackageRepositoryRead(d, m)
+}
+
+func resourcePackageRepositoryRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	repo, err := gmaw.NewPackageRepository(mo).Get(id)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	tfstate := map[string]interface{}{
+		"name":             repo.Name,
+		"url":              repo.URL,
+		"distributions":    repo.Distributions,
+		"components":       repo.Components,
+		"disabled_pockets": repo.DisabledPockets,
+		"arches":           repo.Arches,
+		"key":              repo.Key,
+		"enabled":          repo.Enabled,
+	}
+	for k, v := range tfstate {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourcePackageRepositoryUpdate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	if _, err := gmaw.NewPackageRepository(mo).Put(id, resourcePackageRepositoryParams(d)); err != nil {
+		return err
+	}
+	return resourcePackageRepositoryRead(d, m)
+}
+
+func resourcePackageRepositoryDelete(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	if err := gmaw.NewPackageRepository(mo).Delete(id); err != nil && !isNotFound(err) {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourcePackageRepositoryParams returns the parameters for creating or updating a repository
+// from the resource data. Source packages are left disabled, as they are by default in MaaS.
+func resourcePackageRepositoryParams(d *schema.ResourceData) *params.PackageRepository {
+	return &params.PackageRepository{
+		Name:            d.Get("name").(string),
+		URL:             d.Get("url").(string),
+		Key:             d.Get("key").(string),
+		Distributions:   listToStrings(d.Get("distributions").([]interface{})),
+		Components:      listToStrings(d.Get("components").([]interface{})),
+		DisabledPockets: setToStrings(d.Get("disabled_pockets").(*schema.Set)),
+		Arches:          listToStrings(d.Get("arches").([]interface{})),
+		DisableSources:  true,
+		Enabled:         d.Get("enabled").(bool),
+	}
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+)
+
+// ResourceRackControllerImageSync imports the boot images into the region and syncs them
+// to the rack controllers, blocking until every rack controller reports its images as synced.
+// It is meant for bringing up a new site, so that machines can be deployed as soon as it is created.
+func ResourceRackControllerImageSync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRackControllerImageSyncCreate,
+		Read:   resourceRackControllerImageSyncRead,
+		Delete: resourceRackControllerImageSyncDelete,
+
+		Schema: map[string]*schema.Schema{
+			"system_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"import_region": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"triggers": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute), // nolint: gomnd
+		},
+	}
+}
+
+func resourceRackControllerImageSyncCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	timeout := d.Timeout(schema.TimeoutCreate)
+	start := time.Now()
+
+	// The rack controllers sync their images from the region, so the region imports them first
+	if d.Get("import_region").(bool) {
+		bootResources := gmaw.NewBootResources(mo)
+		if err := bootResources.Import(); err != nil {
+			return err
+		}
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{"importing"},
+			Target:  []string{"imported"},
+			Refresh: func() (interface{}, string, error) {
+				importing, err := bootResources.IsImporting()
+				if err != nil || importing {
+					return importing, "importing", err
+				}
+				return importing, "imported", nil
+			},
+			Timeout:    timeout,
+			Delay:      10 * time.Second, // nolint: gomnd
+			MinTimeout: 5 * time.Second,  // nolint: gomnd
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error waiting for the region to import the boot images: %s", err)
+		}
+	}
+
+	systemIDs, err := resourceRackControllerImageSyncSystemIDs(d, mo)
+	if err != nil {
+		return err
+	}
+	rackController := gmaw.NewRackController(mo)
+	for _, systemID := range systemIDs {
+		if err := rackController.ImportBootImages(systemID); err != nil {
+			return err
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"syncing"},
+		Target:  []string{"synced"},
+		Refresh: func() (interface{}, string, error) {
+			status, err := resourceRackControllerImageSyncStatus(mo, systemIDs)
+			if err != nil {
+				return nil, "", err
+			}
+			for _, s := range status {
+				if s != "synced" {
+					return status, "syncing", nil
+				}
+			}
+			return status, "synced", nil
+		},
+		Timeout:    timeout - time.Since(start),
+		Delay:      10 * time.Second, // nolint: gomnd
+		MinTimeout: 5 * time.Second,  // nolint: gomnd
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for the rack controllers to sync the boot images: %s", err)
+	}
+
+	d.SetId(time.Now().Format(time.RFC3339))
+	return resourceRackControllerImageSyncRead(d, m)
+}
+
+// resourceRackControllerImageSyncRead refreshes the sync status of the rack controllers.
+// A rack controller falling out of sync is reported in the status, but does not trigger a new sync.
+func resourceRackControllerImageSyncRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	systemIDs, err := resourceRackControllerImageSyncSystemIDs(d, mo)
+	if err != nil {
+		return err
+	}
+	status, err := resourceRackControllerImageSyncStatus(mo, systemIDs)
+	if err != nil {
+		return err
+	}
+	return d.Set("status", status)
+}
+
+// resourceRackControllerImageSyncDelete leaves the images on the rack controllers.
+func resourceRackControllerImageSyncDelete(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// resourceRackControllerImageSyncSystemIDs returns the system IDs of the rack controllers to sync,
+// which are all of the rack controllers when none are configured.
+func resourceRackControllerImageSyncSystemIDs(d *schema.ResourceData, mo *gomaasapi.MAASObject) ([]string, error) {
+	systemIDs := setToStrings(d.Get("system_ids").(*schema.Set))
+	if len(systemIDs) == 0 {
+		ctrls, err := gmaw.NewRackControllers(mo).Get(nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, ctrl := range ctrls {
+			systemIDs = append(systemIDs, ctrl.SystemID)
+		}
+	}
+	sort.Strings(systemIDs)
+	return systemIDs, nil
+}
+
+// resourceRackControllerImageSyncStatus returns the boot image sync status of each rack controller.
+func resourceRackControllerImageSyncStatus(mo *gomaasapi.MAASObject, systemIDs []string) (map[string]string, error) {
+	rackController := gmaw.NewRackController(mo)
+	status := make(map[string]string, len(systemIDs))
+	for _, systemID := range systemIDs {
+		images, err := rackController.ListBootImages(systemID)
+		if err != nil {
+			return nil, err
+		}
+		status[systemID] = images.Status
+	}
+	return status, nil
+}
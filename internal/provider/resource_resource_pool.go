@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+)
+
+// ResourceResourcePool manages a MaaS Resource Pool
+func ResourceResourcePool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceResourcePoolCreate,
+		Read:   resourceResourcePoolRead,
+		Update: resourceResourcePoolUpdate,
+		Delete: resourceResourcePoolDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceResourcePoolCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	pool, err := gmaw.NewResourcePools(mo).Post(resourceResourcePoolParams(d))
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.Itoa(pool.ID))
+	return resourceResourcePoolRead(d, m)
+}
+
+func resourceResourcePoolRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	pool, err := gmaw.NewResourcePool(mo).Get(id)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	if err := d.Set("name", pool.Name); err != nil {
+		return err
+	}
+	return d.Set("description", pool.Description)
+}
+
+func resourceResourcePoolUpdate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	if _, err := gmaw.NewResourcePool(mo).Put(id, resourceResourcePoolParams(d)); err != nil {
+		return err
+	}
+	return resourceResourcePoolRead(d, m)
+}
+
+// resourceResourcePoolDelete removes the resource pool. MaaS moves its machines to the default pool.
+func resourceResourcePoolDelete(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	if err := gmaw.NewResourcePool(mo).Delete(id); err != nil && !isNotFound(err) {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceResourcePoolParams returns the parameters for creating or updating a resource pool
+// from the resource data.
+func resourceResourcePoolParams(d *schema.ResourceData) *params.ResourcePool {
+	return &params.ResourcePool{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+}
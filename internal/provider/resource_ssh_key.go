@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// ResourceSSHKey manages the SSH public keys of the user the provider is authenticated as,
+// either a single key or all of the keys published for a Launchpad or GitHub user.
+// MaaS installs the keys of the user on the machines the user deploys.
+func ResourceSSHKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSSHKeyCreate,
+		Read:   resourceSSHKeyRead,
+		Delete: resourceSSHKeyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"key": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"keysource"},
+				DiffSuppressFunc: suppressSurroundingSpace,
+			},
+			"keysource": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"key"},
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					if !(strings.HasPrefix(v, "lp:") || strings.HasPrefix(v, "gh:")) || len(v) < 4 {
+						errs = append(errs, fmt.Errorf("%q must be 'lp:<user>' or 'gh:<user>' (got '%s')", key, v))
+					}
+					return
+				},
+			},
+			"keys": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: resourceSSHKeyImport,
+		},
+	}
+}
+
+// suppressSurroundingSpace ignores the leading and trailing whitespace MaaS strips from keys,
+// such as the newline at the end of a key read from a file.
+func suppressSurroundingSpace(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
+
+// resourceSSHKeyCreate adds the key, or imports the keys of the keysource. The ID is the ID
+// of the key, or the keysource itself since it stands for any number of keys.
+func resourceSSHKeyCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	if keySource := d.Get("keysource").(string); keySource != "" {
+		if _, err := gmaw.NewSSHKeys(mo).Import(keySource); err != nil {
+			return err
+		}
+		d.SetId(keySource)
+		return resourceSSHKeyRead(d, m)
+	}
+
+	key := d.Get("key").(string)
+	if key == "" {
+		return fmt.Errorf("one of key or keysource must be set")
+	}
+	sshKey, err := gmaw.NewSSHKeys(mo).Post(key)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.Itoa(sshKey.ID))
+	return resourceSSHKeyRead(d, m)
+}
+
+func resourceSSHKeyRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	if keySource := d.Get("keysource").(string); keySource != "" {
+		sshKeys, err := resourceSSHKeyImported(mo, keySource)
+		if err != nil {
+			return err
+		}
+		if len(sshKeys) == 0 {
+			d.SetId("")
+			return nil
+		}
+		keys := make([]string, 0, len(sshKeys))
+		for _, sshKey := range sshKeys {
+			keys = append(keys, sshKey.Key)
+		}
+		return d.Set("keys", keys)
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	sshKey, err := gmaw.NewSSHKey(mo).Get(id)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	if err := d.Set("key", sshKey.Key); err != nil {
+		return err
+	}
+	return d.Set("keys", []string{sshKey.Key})
+}
+
+func resourceSSHKeyDelete(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	var ids []int
+	if keySource := d.Get("keysource").(string); keySource != "" {
+		sshKeys, err := resourceSSHKeyImported(mo, keySource)
+		if err != nil {
+			return err
+		}
+		for _, sshKey := range sshKeys {
+			ids = append(ids, sshKey.ID)
+		}
+	} else {
+		id, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+
+	for _, id := range ids {
+		if err := gmaw.NewSSHKey(mo).Delete(id); err != nil && !isNotFound(err) {
+			return err
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceSSHKeyImport imports a single key by ID, or the keys of a keysource by the keysource.
+func resourceSSHKeyImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), ":") {
+		if err := d.Set("keysource", d.Id()); err != nil {
+			return nil, err
+		}
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceSSHKeyImported returns the keys of the user that were imported from <keySource>.
+func resourceSSHKeyImported(mo *gomaasapi.MAASObject, keySource string) ([]entity.SSHKey, error) {
+	sshKeys, err := gmaw.NewSSHKeys(mo).Get()
+	if err != nil {
+		return nil, err
+	}
+	var res []entity.SSHKey
+	for _, sshKey := range sshKeys {
+		if sshKey.KeySource == keySource {
+			res = append(res, sshKey)
+		}
+	}
+	return res, nil
+}
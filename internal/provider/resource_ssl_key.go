@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+)
+
+// ResourceSSLKey manages an SSL certificate of the user the provider is authenticated as.
+func ResourceSSLKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSSLKeyCreate,
+		Read:   resourceSSLKeyRead,
+		Delete: resourceSSLKeyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"key": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressSurroundingSpace,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceSSLKeyCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	sslKey, err := gmaw.NewSSLKeys(mo).Post(d.Get("key").(string))
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.Itoa(sslKey.ID))
+	return resourceSSLKeyRead(d, m)
+}
+
+func resourceSSLKeyRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	sslKey, err := gmaw.NewSSLKey(mo).Get(id)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return d.Set("key", sslKey.Key)
+}
+
+func resourceSSLKeyDelete(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	if err := gmaw.NewSSLKey(mo).Delete(id); err != nil && !isNotFound(err) {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// ResourceTag manages a MaaS Tag
+func ResourceTag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTagCreate,
+		Read:   resourceTagRead,
+		Update: resourceTagUpdate,
+		Delete: resourceTagDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"definition": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"kernel_opts": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceTagCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	tag, err := gmaw.NewTags(mo).Post(resourceTagParams(d))
+	if err != nil {
+		return err
+	}
+	d.SetId(tag.Name)
+	return resourceTagRead(d, m)
+}
+
+func resourceTagRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	tag, err := gmaw.NewTag(mo).Get(d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return resourceTagUpdateResource(d, tag)
+}
+
+func resourceTagUpdate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	tag, err := gmaw.NewTag(mo).Put(d.Id(), resourceTagParams(d))
+	if err != nil {
+		return err
+	}
+	d.SetId(tag.Name)
+	return resourceTagRead(d, m)
+}
+
+func resourceTagDelete(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	if err := gmaw.NewTag(mo).Delete(d.Id()); err != nil && !isNotFound(err) {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceTagParams returns the parameters for creating or updating a tag from the resource data.
+func resourceTagParams(d *schema.ResourceData) *params.Tag {
+	return &params.Tag{
+		Name:       d.Get("name").(string),
+		Comment:    d.Get("comment").(string),
+		Definition: d.Get("definition").(string),
+		KernelOpts: d.Get("kernel_opts").(string),
+	}
+}
+
+// resourceTagUpdateResource sets the resource data from a tag.
+func resourceTagUpdateResource(d *schema.ResourceData, tag *entity.Tag) error {
+	for key, val := range map[string]string{
+		"name":        tag.Name,
+		"definition":  tag.Definition,
+		"comment":     tag.Comment,
+		"kernel_opts": tag.KernelOpts,
+	} {
+		if err := d.Set(key, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
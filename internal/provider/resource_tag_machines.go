@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+)
+
+// ResourceTagMachines manages the machines attached to a manual MaaS Tag.
+// The list of machines is authoritative: machines attached to the tag
+// outside of Terraform are detached on the next apply.
+func ResourceTagMachines() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTagMachinesCreate,
+		Read:   resourceTagMachinesRead,
+		Update: resourceTagMachinesUpdate,
+		Delete: resourceTagMachinesDelete,
+
+		Schema: map[string]*schema.Schema{
+			"tag": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"system_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceTagMachinesCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	name := d.Get("tag").(string)
+
+	// MaaS manages the machines of a tag with a definition itself
+	tag, err := gmaw.NewTag(mo).Get(name)
+	if err != nil {
+		return err
+	}
+	if tag.Definition != "" {
+		return fmt.Errorf("tag %s has a definition, only manual tags can have their machines managed", name)
+	}
+
+	p := &params.TagUpdateNodes{Add: setToStrings(d.Get("system_ids").(*schema.Set))}
+	if _, _, err := gmaw.NewTag(mo).UpdateNodes(name, p); err != nil {
+		return err
+	}
+	d.SetId(name)
+	return resourceTagMachinesRead(d, m)
+}
+
+func resourceTagMachinesRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	nodes, err := gmaw.NewTag(mo).GetNodes(d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	systemIDs := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		systemIDs = append(systemIDs, n.SystemID)
+	}
+	if err := d.Set("tag", d.Id()); err != nil {
+		return err
+	}
+	return d.Set("system_ids", systemIDs)
+}
+
+func resourceTagMachinesUpdate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	if d.HasChange("system_ids") {
+		oldIDs, newIDs := d.GetChange("system_ids")
+		p := &params.TagUpdateNodes{
+			Add:    setToStrings(newIDs.(*schema.Set).Difference(oldIDs.(*schema.Set))),
+			Remove: setToStrings(oldIDs.(*schema.Set).Difference(newIDs.(*schema.Set))),
+		}
+		if _, _, err := gmaw.NewTag(mo).UpdateNodes(d.Id(), p); err != nil {
+			return err
+		}
+	}
+	return resourceTagMachinesRead(d, m)
+}
+
+// resourceTagMachinesDelete detaches the machines from the tag, leaving the tag itself in place.
+func resourceTagMachinesDelete(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	p := &params.TagUpdateNodes{Remove: setToStrings(d.Get("system_ids").(*schema.Set))}
+	if _, _, err := gmaw.NewTag(mo).UpdateNodes(d.Id(), p); err != nil && !isNotFound(err) {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+)
+
+// ResourceUser manages a MaaS User. MaaS cannot update users through its API,
+// so any change replaces the user.
+func ResourceUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUserCreate,
+		Read:   resourceUserRead,
+		Delete: resourceUserDelete,
+
+		Schema: map[string]*schema.Schema{
+			"username": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"email": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+				// An imported user has no password in the state, since MaaS does not return it
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != "" && old == ""
+				},
+			},
+			"admin": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceUserCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	user, err := gmaw.NewUsers(mo).Post(&params.User{
+		Username:    d.Get("username").(string),
+		Email:       d.Get("email").(string),
+		Password:    d.Get("password").(string),
+		IsSuperuser: d.Get("admin").(bool),
+	})
+	if err != nil {
+		return err
+	}
+	d.SetId(user.Username)
+	return resourceUserRead(d, m)
+}
+
+// resourceUserRead refreshes everything but the password, which MaaS does not return.
+func resourceUserRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	user, err := gmaw.NewUser(mo).Get(d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	if err := d.Set("username", user.Username); err != nil {
+		return err
+	}
+	if err := d.Set("email", user.Email); err != nil {
+		return err
+	}
+	return d.Set("admin", user.IsSuperuser)
+}
+
+func resourceUserDelete(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	if err := gmaw.NewUser(mo).Delete(d.Id()); err != nil && !isNotFound(err) {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
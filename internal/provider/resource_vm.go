@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity/node"
+)
+
+// ResourceVM manages a machine composed on a MaaS Pod
+func ResourceVM() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVMCreate,
+		Read:   resourceVMRead,
+		Delete: resourceVMDelete,
+
+		Schema: map[string]*schema.Schema{
+			"vm_host": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"cores": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"memory": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"hostname": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"pool": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"disk": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"size": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"pool": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"interface": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"subnet_cidr": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"space": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"ip_address": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"system_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute), // nolint: gomnd
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceVMCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	p := &params.PodCompose{
+		Hostname: d.Get("hostname").(string),
+		Zone:     d.Get("zone").(string),
+		Pool:     d.Get("pool").(string),
+		Cores:    d.Get("cores").(int),
+		Memory:   d.Get("memory").(int),
+	}
+	for _, disk := range d.Get("disk").([]interface{}) {
+		disk := disk.(map[string]interface{})
+		p.Disks = append(p.Disks, params.PodComposeDisk{
+			Size: disk["size"].(int),
+			Pool: disk["pool"].(string),
+		})
+	}
+	for _, iface := range d.Get("interface").([]interface{}) {
+		iface := iface.(map[string]interface{})
+		p.Interfaces = append(p.Interfaces, params.PodComposeInterface{
+			Name:       iface["name"].(string),
+			SubnetCIDR: iface["subnet_cidr"].(string),
+			Space:      iface["space"].(string),
+			IPAddress:  iface["ip_address"].(string),
+		})
+	}
+
+	ma, err := gmaw.NewPod(mo).Compose(d.Get("vm_host").(int), p)
+	if err != nil {
+		return err
+	}
+	d.SetId(ma.SystemID)
+
+	// The composed machine is commissioned before it can be used
+	machineManager, err := maas.NewMachineManager(ma.SystemID, gmaw.NewMachine(mo))
+	if err != nil {
+		return err
+	}
+	status, err := machineManager.WaitWhile(d.Timeout(schema.TimeoutCreate),
+		node.StatusNew, node.StatusCommissioning, node.StatusTesting)
+	if err != nil {
+		return err
+	}
+	if status != node.StatusReady {
+		return fmt.Errorf("VM %s failed to commission (status is %d)", ma.SystemID, status)
+	}
+	return resourceVMRead(d, m)
+}
+
+func resourceVMRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	machineManager, err := maas.NewMachineManager(d.Id(), gmaw.NewMachine(mo))
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	ma := machineManager.Current()
+	pod, err := gmaw.NewPod(mo).Get(ma.Pod.ID)
+	if err != nil {
+		return err
+	}
+	for key, val := range map[string]interface{}{
+		"vm_host":   ma.Pod.ID,
+		"cores":     ma.CPUCount,
+		"memory":    ma.Memory,
+		"hostname":  ma.Hostname,
+		"zone":      ma.Zone.Name,
+		"pool":      ma.Pool.Name,
+		"system_id": ma.SystemID,
+		"disk":      resourceVMDisks(ma, pod),
+		"interface": resourceVMInterfaces(ma),
+	} {
+		if err := d.Set(key, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resourceVMDelete deletes the machine, which decomposes it and frees its resources on the VM host.
+func resourceVMDelete(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	machineManager, err := maas.NewMachineManager(d.Id(), gmaw.NewMachine(mo))
+	if err == nil {
+		err = machineManager.Delete()
+	}
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceVMDisks flattens the disks of the machine. MaaS reports the sizes in bytes and the
+// storage pools by ID, while the resource uses gigabytes and the pool names.
+func resourceVMDisks(ma *maas.Machine, pod *entity.Pod) []map[string]interface{} {
+	disks := make([]map[string]interface{}, 0, len(ma.PhysicalBlockDeviceSet))
+	for _, bd := range ma.PhysicalBlockDeviceSet {
+		pool := bd.StoragePool
+		for _, sp := range pod.StoragePools {
+			if sp.ID == bd.StoragePool {
+				pool = sp.Name
+			}
+		}
+		disks = append(disks, map[string]interface{}{
+			"size": bd.Size / 1000 / 1000 / 1000, // nolint: gomnd
+			"pool": pool,
+		})
+	}
+	return disks
+}
+
+// resourceVMInterfaces flattens the interfaces of the machine, with the subnet, space and static
+// IP address of their first link.
+func resourceVMInterfaces(ma *maas.Machine) []map[string]interface{} {
+	ifaces := make([]map[string]interface{}, 0, len(ma.InterfaceSet))
+	for _, iface := range ma.InterfaceSet {
+		val := map[string]interface{}{
+			"name":        iface.Name,
+			"subnet_cidr": "",
+			"space":       "",
+			"ip_address":  "",
+		}
+		if len(iface.Links) > 0 {
+			link := iface.Links[0]
+			val["subnet_cidr"] = link.Subnet.CIDR
+			val["space"] = link.Subnet.Space
+			if link.Mode == "static" {
+				val["ip_address"] = link.IPAddress
+			}
+		}
+		ifaces = append(ifaces, val)
+	}
+	return ifaces
+}
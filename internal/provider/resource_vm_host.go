@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// ResourceVMHost manages a MaaS Pod, ie a host that machines can be composed on
+func ResourceVMHost() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVMHostCreate,
+		Read:   resourceVMHostRead,
+		Update: resourceVMHostUpdate,
+		Delete: resourceVMHostDelete,
+
+		Schema: map[string]*schema.Schema{
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					if !(v == "virsh" || v == "lxd") {
+						errs = append(errs, fmt.Errorf("%q must be 'virsh' or 'lxd' (got '%s')", key, v))
+					}
+					return
+				},
+			},
+			"power_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"power_pass": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"pool": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"cpu_over_commit_ratio": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
+			},
+			"memory_over_commit_ratio": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
+			},
+			"default_storage_pool": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceVMHostCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	pod, err := gmaw.NewPods(mo).Post(resourceVMHostParams(d))
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.Itoa(pod.ID))
+
+	// The storage pools are only known once MaaS has connected to the host
+	if _, ok := d.GetOk("default_storage_pool"); ok {
+		// The zone and pool are always sent, so keep the ones MaaS picked when they are unset
+		p := resourceVMHostParams(d)
+		if p.Zone == "" {
+			p.Zone = pod.Zone.Name
+		}
+		if p.Pool == "" {
+			p.Pool = pod.Pool.Name
+		}
+		if p.DefaultStoragePool, err = resourceVMHostStoragePoolID(d, pod); err != nil {
+			return err
+		}
+		if _, err := gmaw.NewPod(mo).Put(pod.ID, p); err != nil {
+			return err
+		}
+	}
+	return resourceVMHostRead(d, m)
+}
+
+func resourceVMHostRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	pod, err := gmaw.NewPod(mo).Get(id)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	powerParams, err := gmaw.NewPod(mo).GetParameters(id)
+	if err != nil {
+		return err
+	}
+
+	var defaultPool string
+	for _, sp := range pod.StoragePools {
+		if sp.Default {
+			defaultPool = sp.Name
+		}
+	}
+	for key, val := range map[string]interface{}{
+		"type":                     pod.Type,
+		"power_address":            powerParams["power_address"],
+		"name":                     pod.Name,
+		"zone":                     pod.Zone.Name,
+		"pool":                     pod.Pool.Name,
+		"tags":                     pod.Tags,
+		"cpu_over_commit_ratio":    pod.CPUOverCommitRatio,
+		"memory_over_commit_ratio": pod.MemoryOverCommitRatio,
+		"default_storage_pool":     defaultPool,
+	} {
+		if err := d.Set(key, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceVMHostUpdate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	pod, err := gmaw.NewPod(mo).Get(id)
+	if err != nil {
+		return err
+	}
+
+	p := resourceVMHostParams(d)
+	if p.DefaultStoragePool, err = resourceVMHostStoragePoolID(d, pod); err != nil {
+		return err
+	}
+	if _, err := gmaw.NewPod(mo).Put(id, p); err != nil {
+		return err
+	}
+	return resourceVMHostRead(d, m)
+}
+
+// resourceVMHostDelete removes the pod. MaaS decomposes the machines composed on it.
+func resourceVMHostDelete(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	if err := gmaw.NewPod(mo).Delete(id); err != nil && !isNotFound(err) {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceVMHostParams returns the parameters for registering or updating a pod from the resource data.
+func resourceVMHostParams(d *schema.ResourceData) *params.Pod {
+	return &params.Pod{
+		Type:                  d.Get("type").(string),
+		PowerAddress:          d.Get("power_address").(string),
+		PowerPass:             d.Get("power_pass").(string),
+		Name:                  d.Get("name").(string),
+		Zone:                  d.Get("zone").(string),
+		Pool:                  d.Get("pool").(string),
+		Tags:                  setToStrings(d.Get("tags").(*schema.Set)),
+		CPUOverCommitRatio:    d.Get("cpu_over_commit_ratio").(float64),
+		MemoryOverCommitRatio: d.Get("memory_over_commit_ratio").(float64),
+	}
+}
+
+// resourceVMHostStoragePoolID returns the ID of the storage pool named by default_storage_pool.
+// MaaS identifies storage pools by ID, while the resource uses the more readable name.
+func resourceVMHostStoragePoolID(d *schema.ResourceData, pod *entity.Pod) (string, error) {
+	name := d.Get("default_storage_pool").(string)
+	if name == "" {
+		return "", nil
+	}
+	for _, sp := range pod.StoragePools {
+		if sp.Name == name {
+			return sp.ID, nil
+		}
+	}
+	return "", fmt.Errorf("VM host %s does not have a storage pool named %s", pod.Name, name)
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+)
+
+// ResourceZone manages a MaaS availability Zone
+func ResourceZone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceZoneCreate,
+		Read:   resourceZoneRead,
+		Update: resourceZoneUpdate,
+		Delete: resourceZoneDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceZoneCreate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	zone, err := gmaw.NewZones(mo).Post(resourceZoneParams(d))
+	if err != nil {
+		return err
+	}
+	d.SetId(zone.Name)
+	return resourceZoneRead(d, m)
+}
+
+func resourceZoneRead(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	zone, err := gmaw.NewZone(mo).Get(d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	if err := d.Set("name", zone.Name); err != nil {
+		return err
+	}
+	return d.Set("description", zone.Description)
+}
+
+func resourceZoneUpdate(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	zone, err := gmaw.NewZone(mo).Put(d.Id(), resourceZoneParams(d))
+	if err != nil {
+		return err
+	}
+	d.SetId(zone.Name)
+	return resourceZoneRead(d, m)
+}
+
+// resourceZoneDelete removes the zone. MaaS moves its machines to the default zone.
+func resourceZoneDelete(d *schema.ResourceData, m interface{}) error {
+	mo := maasClient(m)
+	if err := gmaw.NewZone(mo).Delete(d.Id()); err != nil && !isNotFound(err) {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceZoneParams returns the parameters for creating or updating a zone from the resource data.
+func resourceZoneParams(d *schema.ResourceData) *params.Zone {
+	return &params.Zone{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+}
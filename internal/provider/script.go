@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// scriptTypes, scriptHardwareTypes and scriptParallel map the values MaaS returns for
+// the type, hardware_type and parallel fields of a script to the names it takes.
+var (
+	scriptTypes         = map[int]string{0: "commissioning", 2: "testing"}
+	scriptHardwareTypes = map[int]string{0: "node", 1: "cpu", 2: "memory", 3: "storage", 4: "network"}
+	scriptParallel      = map[int]string{0: "disabled", 1: "instance", 2: "any"}
+)
+
+// ParseScriptTimeout returns the number of seconds in the timeout of a script, which MaaS
+// formats like a Python timedelta, ie "0:05:00" or "1 day, 2:00:00".
+func ParseScriptTimeout(timeout string) (int, error) {
+	var days int
+	if idx := strings.Index(timeout, ", "); idx >= 0 {
+		fields := strings.Fields(timeout[:idx])
+		if len(fields) != 2 || !strings.HasPrefix(fields[1], "day") {
+			return 0, fmt.Errorf("invalid script timeout '%s'", timeout)
+		}
+		var err error
+		if days, err = strconv.Atoi(fields[0]); err != nil {
+			return 0, fmt.Errorf("invalid script timeout '%s'", timeout)
+		}
+		timeout = timeout[idx+2:]
+	}
+
+	parts := strings.Split(timeout, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid script timeout '%s'", timeout)
+	}
+	res := days * 24
+	for idx, part := range parts {
+		// Microseconds are dropped, since the timeout is set in seconds
+		if idx == 2 {
+			part = strings.SplitN(part, ".", 2)[0]
+		}
+		v, err := strconv.Atoi(part)
+		if err != nil {
+			return 0, fmt.Errorf("invalid script timeout '%s'", timeout)
+		}
+		if idx > 0 {
+			res *= 60
+		}
+		res += v
+	}
+	return res, nil
+}
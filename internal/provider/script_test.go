@@ -0,0 +1,38 @@
+package provider_test
+
+import (
+	"testing"
+
+	. "github.com/roblox/terraform-provider-maas/internal/provider"
+)
+
+func TestParseScriptTimeout(t *testing.T) {
+	tests := []struct {
+		timeout string
+		seconds int
+		valid   bool
+	}{
+		{"0:00:00", 0, true},
+		{"0:05:00", 300, true},
+		{"1:05:30", 3930, true},
+		{"0:00:10.500000", 10, true},
+		{"1 day, 2:00:00", 93600, true},
+		{"3 days, 0:00:01", 259201, true},
+		{"", 0, false},
+		{"5:00", 0, false},
+		{"a:00:00", 0, false},
+		{"1 week, 0:00:00", 0, false},
+	}
+	for _, tc := range tests {
+		got, err := ParseScriptTimeout(tc.timeout)
+		if tc.valid && err != nil {
+			t.Errorf("ParseScriptTimeout(%q) = %s, want no error", tc.timeout, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("ParseScriptTimeout(%q) returned no error", tc.timeout)
+		}
+		if got != tc.seconds {
+			t.Errorf("ParseScriptTimeout(%q) = %d, want %d", tc.timeout, got, tc.seconds)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ServerConfigKind is how the value of a MaaS configuration key is represented.
+// The MaaS Server endpoint returns every value JSON encoded, and takes every value
+// as a string, so each kind parses and formats its values to round-trip through
+// the API without spurious differences.
+type ServerConfigKind string
+
+// The kinds of configuration values
+const (
+	ServerConfigString ServerConfigKind = "string"
+	ServerConfigBool   ServerConfigKind = "bool"
+	ServerConfigInt    ServerConfigKind = "int"
+	ServerConfigList   ServerConfigKind = "list"
+)
+
+// Parse decodes a value returned by the MaaS Server endpoint. A null value parses to
+// the zero value of the kind. Strings are parsed leniently, so a list or boolean that
+// MaaS stores as a string (eg "8.8.8.8 8.8.4.4" or "true") is parsed as well.
+// Numbers are kept as written, so that large numbers are not reformatted (eg 1e+06).
+func (k ServerConfigKind) Parse(raw string) (interface{}, error) {
+	var val interface{}
+	dec := json.NewDecoder(strings.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(&val); err != nil {
+		return nil, fmt.Errorf("cannot decode configuration value '%s': %s", raw, err)
+	}
+
+	switch k {
+	case ServerConfigBool:
+		switch v := val.(type) {
+		case nil:
+			return false, nil
+		case bool:
+			return v, nil
+		case string:
+			return strconv.ParseBool(v)
+		}
+	case ServerConfigInt:
+		switch v := val.(type) {
+		case nil:
+			return 0, nil
+		case json.Number:
+			return strconv.Atoi(v.String())
+		case string:
+			return strconv.Atoi(v)
+		}
+	case ServerConfigList:
+		switch v := val.(type) {
+		case nil:
+			return []string{}, nil
+		case string:
+			// Lists are delimited by commas and/or whitespace
+			return strings.FieldsFunc(v, func(r rune) bool {
+				return r == ',' || unicode.IsSpace(r)
+			}), nil
+		case []interface{}:
+			res := make([]string, 0, len(v))
+			for _, elem := range v {
+				res = append(res, fmt.Sprint(elem))
+			}
+			return res, nil
+		}
+	default:
+		switch v := val.(type) {
+		case nil:
+			return "", nil
+		case string:
+			return v, nil
+		case []interface{}:
+			// Lists are written the way Format writes them, and objects as JSON
+			return k.Format(v), nil
+		case map[string]interface{}:
+			obj, err := json.Marshal(v)
+			return string(obj), err
+		default:
+			return fmt.Sprint(v), nil
+		}
+	}
+	return nil, fmt.Errorf("configuration value '%s' is not a %s", raw, k)
+}
+
+// Format encodes a value of the kind for the MaaS Server endpoint.
+// Lists are joined with spaces, which MaaS accepts for every list setting.
+func (k ServerConfigKind) Format(val interface{}) string {
+	switch v := val.(type) {
+	case []string:
+		return strings.Join(v, " ")
+	case []interface{}:
+		elems := make([]string, 0, len(v))
+		for _, elem := range v {
+			elems = append(elems, fmt.Sprint(elem))
+		}
+		return strings.Join(elems, " ")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Normalize returns the canonical form of a value given as a string, so that
+// values MaaS formats differently compare equal (eg "a,b" and "a b" as lists, or
+// "True" and "true" as booleans). A value that does not parse is returned as is.
+func (k ServerConfigKind) Normalize(val string) string {
+	raw, err := json.Marshal(val)
+	if err != nil {
+		return val
+	}
+	parsed, err := k.Parse(string(raw))
+	if err != nil {
+		return val
+	}
+	return k.Format(parsed)
+}
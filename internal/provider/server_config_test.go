@@ -0,0 +1,100 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	. "github.com/roblox/terraform-provider-maas/internal/provider"
+)
+
+func TestServerConfigKind_Parse(t *testing.T) {
+	tests := []struct {
+		kind ServerConfigKind
+		raw  string
+		want interface{}
+	}{
+		{kind: ServerConfigString, raw: `"bionic"`, want: "bionic"},
+		{kind: ServerConfigString, raw: `null`, want: ""},
+		{kind: ServerConfigString, raw: `1000000`, want: "1000000"},
+		{kind: ServerConfigString, raw: `["a", "b"]`, want: "a b"},
+		{kind: ServerConfigString, raw: `{"a": 1}`, want: `{"a":1}`},
+		{kind: ServerConfigBool, raw: `true`, want: true},
+		{kind: ServerConfigBool, raw: `"false"`, want: false},
+		{kind: ServerConfigBool, raw: `null`, want: false},
+		{kind: ServerConfigInt, raw: `10800`, want: 10800},
+		{kind: ServerConfigInt, raw: `"3600"`, want: 3600},
+		{kind: ServerConfigList, raw: `"8.8.8.8 8.8.4.4"`, want: []string{"8.8.8.8", "8.8.4.4"}},
+		{kind: ServerConfigList, raw: `"a.ntp.org, b.ntp.org"`, want: []string{"a.ntp.org", "b.ntp.org"}},
+		{kind: ServerConfigList, raw: `""`, want: []string{}},
+		{kind: ServerConfigList, raw: `null`, want: []string{}},
+	}
+
+	for _, testCase := range tests {
+		tc := testCase
+		t.Run(string(tc.kind)+" "+tc.raw, func(t *testing.T) {
+			got, err := tc.kind.Parse(tc.raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Fatalf("Parse() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		if _, err := ServerConfigBool.Parse(`"maybe"`); err == nil {
+			t.Fatal("Expected an error parsing a non-boolean string")
+		}
+		if _, err := ServerConfigInt.Parse(`true`); err == nil {
+			t.Fatal("Expected an error parsing a boolean as an int")
+		}
+	})
+}
+
+func TestServerConfigKind_Format(t *testing.T) {
+	tests := []struct {
+		kind ServerConfigKind
+		val  interface{}
+		want string
+	}{
+		{kind: ServerConfigString, val: "bionic", want: "bionic"},
+		{kind: ServerConfigBool, val: true, want: "true"},
+		{kind: ServerConfigInt, val: 3600, want: "3600"},
+		{kind: ServerConfigList, val: []interface{}{"8.8.8.8", "8.8.4.4"}, want: "8.8.8.8 8.8.4.4"},
+	}
+
+	for _, testCase := range tests {
+		tc := testCase
+		t.Run(tc.want, func(t *testing.T) {
+			if got := tc.kind.Format(tc.val); got != tc.want {
+				t.Fatalf("Format() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestServerConfigKind_Normalize(t *testing.T) {
+	tests := []struct {
+		kind ServerConfigKind
+		val  string
+		want string
+	}{
+		{kind: ServerConfigString, val: " as is ", want: " as is "},
+		{kind: ServerConfigBool, val: "True", want: "true"},
+		{kind: ServerConfigBool, val: "maybe", want: "maybe"},
+		{kind: ServerConfigInt, val: "3600", want: "3600"},
+		{kind: ServerConfigList, val: "8.8.8.8,8.8.4.4", want: "8.8.8.8 8.8.4.4"},
+		{kind: ServerConfigList, val: " 8.8.8.8,  8.8.4.4 ", want: "8.8.8.8 8.8.4.4"},
+	}
+
+	for _, testCase := range tests {
+		tc := testCase
+		t.Run(string(tc.kind)+" "+tc.val, func(t *testing.T) {
+			if got := tc.kind.Normalize(tc.val); got != tc.want {
+				t.Fatalf("Normalize() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/juju/gomaasapi"
+)
+
+// maasClient returns the MaaS client of the provider meta. The meta is the client itself when
+// the provider of this package is configured, and a type that holds the client, such as the
+// Config of the main package, when the resources are served by another provider.
+func maasClient(m interface{}) *gomaasapi.MAASObject {
+	if c, ok := m.(interface{ MAAS() *gomaasapi.MAASObject }); ok {
+		return c.MAAS()
+	}
+	return m.(*gomaasapi.MAASObject)
+}
+
+// isNotFound returns true if err is a 404 response from the MaaS API.
+func isNotFound(err error) bool {
+	serverErr, ok := gomaasapi.GetServerError(err)
+	return ok && serverErr.StatusCode == http.StatusNotFound
+}
+
+// setToStrings returns the elements of a set of strings as a slice.
+func setToStrings(s *schema.Set) []string {
+	res := make([]string, 0, s.Len())
+	for _, v := range s.List() {
+		res = append(res, v.(string))
+	}
+	return res
+}
+
+// listToStrings returns the elements of a list of strings as a slice.
+func listToStrings(l []interface{}) []string {
+	res := make([]string, 0, len(l))
+	for _, v := range l {
+		res = append(res, v.(string))
+	}
+	return res
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"math"
+	"sort"
+
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// bytesPerGigabyte converts the storage of a pod to the gigabytes used when composing a VM
+const bytesPerGigabyte = 1000 * 1000 * 1000
+
+// VMHostCapacity is the capacity of a VM host (MaaS pod) that is left for composing VMs.
+// Cores and memory are scaled by the overcommit ratios of the pod, while storage cannot
+// be overcommitted. The storage of all storage pools is summed up, and the storage pool
+// with the most available storage is kept apart, since a disk cannot span storage pools.
+type VMHostCapacity struct {
+	Pod                  *entity.Pod
+	CoresTotal           int
+	CoresAvailable       int
+	MemoryTotal          int
+	MemoryAvailable      int
+	StorageTotal         int
+	StorageAvailable     int
+	StoragePoolTotal     int
+	StoragePoolAvailable int
+}
+
+// NewVMHostCapacity computes the capacity of a pod after its overcommit ratios.
+// A ratio that is not set is treated as 1 (no overcommit).
+func NewVMHostCapacity(pod *entity.Pod) *VMHostCapacity {
+	cpuRatio, memRatio := pod.CPUOverCommitRatio, pod.MemoryOverCommitRatio
+	if cpuRatio <= 0 {
+		cpuRatio = 1
+	}
+	if memRatio <= 0 {
+		memRatio = 1
+	}
+
+	c := &VMHostCapacity{Pod: pod}
+	c.CoresTotal = int(math.Floor(float64(pod.Total.Cores) * cpuRatio))
+	c.CoresAvailable = c.CoresTotal - pod.Used.Cores
+	c.MemoryTotal = int(math.Floor(float64(pod.Total.Memory) * memRatio))
+	c.MemoryAvailable = c.MemoryTotal - pod.Used.Memory
+	for _, sp := range pod.StoragePools {
+		c.StorageTotal += sp.Total / bytesPerGigabyte
+		c.StorageAvailable += sp.Available / bytesPerGigabyte
+		if available := sp.Available / bytesPerGigabyte; available > c.StoragePoolAvailable {
+			c.StoragePoolTotal, c.StoragePoolAvailable = sp.Total/bytesPerGigabyte, available
+		}
+	}
+	if len(pod.StoragePools) == 0 {
+		c.StorageTotal = pod.Total.LocalStorage / bytesPerGigabyte
+		c.StorageAvailable = pod.Available.LocalStorage / bytesPerGigabyte
+		c.StoragePoolTotal, c.StoragePoolAvailable = c.StorageTotal, c.StorageAvailable
+	}
+	return c
+}
+
+// Fit returns whether a VM with the given cores, memory (in megabytes) and storage
+// (in gigabytes) fits on the host, and the smallest fraction of the host's cores,
+// memory and storage that would be left once it is composed. Storage is only
+// considered when it is requested, and must fit in a single storage pool. Hosts
+// with a higher score have more headroom left; a host that does not fit has a
+// negative score.
+func (c *VMHostCapacity) Fit(cores, memory, storage int) (fits bool, score float64) {
+	dims := []struct{ total, available, requested int }{
+		{c.CoresTotal, c.CoresAvailable, cores},
+		{c.MemoryTotal, c.MemoryAvailable, memory},
+	}
+	if storage > 0 {
+		dims = append(dims, struct{ total, available, requested int }{c.StoragePoolTotal, c.StoragePoolAvailable, storage})
+	}
+
+	score = 1
+	for _, dim := range dims {
+		if dim.total <= 0 {
+			if dim.requested > 0 {
+				return false, -1
+			}
+			continue
+		}
+		score = math.Min(score, float64(dim.available-dim.requested)/float64(dim.total))
+	}
+	return score >= 0, score
+}
+
+// SortVMHostCapacities sorts the hosts by how well a VM of the given shape fits: hosts the
+// VM fits on come first, then hosts with more headroom left, then hosts with a lower ID.
+func SortVMHostCapacities(caps []*VMHostCapacity, cores, memory, storage int) {
+	sort.SliceStable(caps, func(i, j int) bool {
+		fitsI, scoreI := caps[i].Fit(cores, memory, storage)
+		fitsJ, scoreJ := caps[j].Fit(cores, memory, storage)
+		if fitsI != fitsJ {
+			return fitsI
+		}
+		if scoreI != scoreJ {
+			return scoreI > scoreJ
+		}
+		return caps[i].Pod.ID < caps[j].Pod.ID
+	})
+}
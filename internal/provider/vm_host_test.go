@@ -0,0 +1,102 @@
+package provider_test
+
+import (
+	"testing"
+
+	. "github.com/roblox/terraform-provider-maas/internal/provider"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewVMHostCapacity(t *testing.T) {
+	pod := new(entity.Pod)
+	if err := helper.TestdataFromJSON("maas/pod.json", pod); err != nil {
+		t.Fatal(err)
+	}
+	pod.CPUOverCommitRatio = 2.5
+	pod.MemoryOverCommitRatio = 0
+
+	c := NewVMHostCapacity(pod)
+	if c.CoresTotal != 20 || c.CoresAvailable != 19 {
+		t.Errorf("cores = %d/%d, want 19/20", c.CoresAvailable, c.CoresTotal)
+	}
+	if c.MemoryTotal != 15916 || c.MemoryAvailable != 14892 {
+		t.Errorf("memory = %d/%d, want 14892/15916", c.MemoryAvailable, c.MemoryTotal)
+	}
+	if c.StorageTotal != 47 || c.StorageAvailable != 39 {
+		t.Errorf("storage = %d/%d, want 39/47", c.StorageAvailable, c.StorageTotal)
+	}
+
+	// A VM disk is composed on a single storage pool, so the pool with the most available storage is kept apart
+	pod.StoragePools = append(pod.StoragePools,
+		entity.PodStoragePool{Name: "ssd", Total: 100 * 1000 * 1000 * 1000, Available: 60 * 1000 * 1000 * 1000})
+	c = NewVMHostCapacity(pod)
+	if c.StorageTotal != 147 || c.StorageAvailable != 99 {
+		t.Errorf("storage = %d/%d, want 99/147", c.StorageAvailable, c.StorageTotal)
+	}
+	if c.StoragePoolTotal != 100 || c.StoragePoolAvailable != 60 {
+		t.Errorf("storage pool = %d/%d, want 60/100", c.StoragePoolAvailable, c.StoragePoolTotal)
+	}
+	if fits, _ := c.Fit(0, 0, 80); fits {
+		t.Error("Fit() = true for a disk larger than any storage pool")
+	}
+}
+
+func TestVMHostCapacity_Fit(t *testing.T) {
+	c := &VMHostCapacity{
+		CoresTotal: 10, CoresAvailable: 6,
+		MemoryTotal: 1000, MemoryAvailable: 500,
+		StorageTotal: 100, StorageAvailable: 10,
+		StoragePoolTotal: 100, StoragePoolAvailable: 10,
+	}
+	tests := []struct {
+		name                   string
+		cores, memory, storage int
+		fits                   bool
+		score                  float64
+	}{
+		{name: "empty", fits: true, score: 0.5},
+		{name: "cores", cores: 4, fits: true, score: 0.2},
+		{name: "memory", cores: 1, memory: 400, fits: true, score: 0.1},
+		{name: "storage", storage: 20, fits: false, score: -0.1},
+		{name: "too many cores", cores: 7, fits: false, score: -0.1},
+	}
+
+	for _, testCase := range tests {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			fits, score := c.Fit(tc.cores, tc.memory, tc.storage)
+			if fits != tc.fits || score < tc.score-1e-9 || score > tc.score+1e-9 {
+				t.Fatalf("Fit() = (%t, %f), want (%t, %f)", fits, score, tc.fits, tc.score)
+			}
+		})
+	}
+}
+
+func TestSortVMHostCapacities(t *testing.T) {
+	newCapacity := func(id, cores, available int) *VMHostCapacity {
+		return &VMHostCapacity{
+			Pod:        &entity.Pod{ID: id},
+			CoresTotal: cores, CoresAvailable: available,
+			MemoryTotal: 1000, MemoryAvailable: 1000,
+		}
+	}
+	caps := []*VMHostCapacity{
+		newCapacity(1, 10, 1), // does not fit
+		newCapacity(2, 10, 5), // 30% left
+		newCapacity(3, 10, 9), // 70% left
+		newCapacity(4, 20, 8), // 30% left, higher ID
+	}
+	SortVMHostCapacities(caps, 2, 0, 0)
+
+	var got []int
+	for _, c := range caps {
+		got = append(got, c.Pod.ID)
+	}
+	want := []int{3, 2, 4, 1}
+	for idx := range want {
+		if got[idx] != want[idx] {
+			t.Fatalf("SortVMHostCapacities() = %v, want %v", got, want)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
 	"log"
 	"net/url"
 	"strconv"
@@ -10,6 +12,9 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
 )
 
 // maasListAllNodes This is a *low level* function that access a MAAS Server and returns an array of MAASObject
@@ -234,3 +239,46 @@ func parseConstraints(d *schema.ResourceData) (url.Values, error) { // nolint: u
 
 	return retVal, nil
 }
+
+// deployFailureLogLines is the number of lines of the installation log included in a failed deploy's error
+const deployFailureLogLines = 20
+
+// getDeployFailure Convenience function used by resourceMAASInstanceCreate to describe why a deploy failed.
+// The function takes a fully initialized MAASObject and a system_id, and returns the node's status and
+// status message, followed by the tail of the current installation result (ie the curtin log).
+// Any details that cannot be fetched are omitted, as the description is only used to decorate an error.
+func getDeployFailure(maas *gomaasapi.MAASObject, systemID string) string {
+	log.Printf("[DEBUG] [getDeployFailure] Getting deploy failure details for node: %s", systemID)
+	res, err := gmaw.NewMachine(maas).Get(systemID)
+	if err != nil {
+		log.Printf("[ERROR] [getDeployFailure] Unable to get node (%s): %s", systemID, err)
+		return ""
+	}
+	var machine entity.Machine
+	if err = json.Unmarshal(res, &machine); err != nil {
+		log.Printf("[ERROR] [getDeployFailure] Unable to decode node (%s): %s", systemID, err)
+		return ""
+	}
+
+	var details strings.Builder
+	fmt.Fprintf(&details, "status: %s", machine.StatusName)
+	if machine.StatusMessage != "" {
+		fmt.Fprintf(&details, " (%s)", machine.StatusMessage)
+	}
+	if machine.CurrentInstallationResultID == 0 {
+		return details.String()
+	}
+
+	result, err := gmaw.NewNodeResult(maas).Get(systemID, machine.CurrentInstallationResultID,
+		&params.NodeResultSearch{IncludeOutput: true})
+	if err != nil {
+		log.Printf("[ERROR] [getDeployFailure] Unable to get installation result for node (%s): %s", systemID, err)
+		return details.String()
+	}
+	for idx := range result.Results {
+		if output := tailLines(string(result.Results[idx].Output), deployFailureLogLines); output != "" {
+			fmt.Fprintf(&details, "\n%s:\n%s", result.Results[idx].Name, output)
+		}
+	}
+	return details.String()
+}
@@ -9,6 +9,10 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/roblox/terraform-provider-maas/internal/provider"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity/node"
 )
 
 // resourceMAASInstanceCreate This function doesn't really *create* a new node but, power an already registered
@@ -37,48 +41,39 @@ func resourceMAASInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	d.SetId(nodeObj.systemID)
 
 	// separate constraints that are supported for the deploy action
-	// parameters to pass when creating a node
-	nodeParams := url.Values{}
-
-	// get user data if defined
-	if userData, ok := d.GetOk("user_data"); ok {
-		nodeParams.Add("user_data", base64encode(userData.(string)))
-	}
-
-	// get comment if defined
-	if comment, ok := d.GetOk("comment"); ok {
-		nodeParams.Add("comment", comment.(string))
-	}
-
-	// get distro_series if defined
-	distroSeries, ok := d.GetOk("distro_series")
-	if ok {
-		nodeParams.Add("distro_series", distroSeries.(string))
-	}
-
-	// get hwe_kernel if defined
-	if HWEKernel, ok := d.GetOk("hwe_kernel"); ok {
-		nodeParams.Add("hwe_kernel", HWEKernel.(string))
+	// the osystem and distro_series select the image, including custom images and OSes needing a license key
+	instance := provider.Instance{
+		UserData:        d.Get("user_data").(string),
+		Comment:         d.Get("comment").(string),
+		OSystem:         d.Get("osystem").(string),
+		DistroSeries:    d.Get("distro_series").(string),
+		HWEKernel:       d.Get("hwe_kernel").(string),
+		LicenseKey:      d.Get("license_key").(string),
+		KernelOptions:   d.Get("kernel_options").(string),
+		InstallKVM:      d.Get("install_kvm").(bool),
+		InstallRackD:    d.Get("install_rackd").(bool),
+		EphemeralDeploy: d.Get("ephemeral_deploy").(bool),
+		EnableHWSync:    d.Get("enable_hw_sync").(bool),
 	}
 
 	// install kvm and register the server as a kvm server if requested
-	if installKVM, ok := d.GetOk("install_kvm"); ok {
-		log.Printf("[INFO] Adding KVM packages and configuration: %s", installKVM)
-		nodeParams.Add("install_kvm", strconv.FormatBool(true))
+	if instance.InstallKVM {
+		log.Printf("[INFO] Adding KVM packages and configuration: %t", instance.InstallKVM)
 	}
 
 	// install rackd if requested
-	if installRackD, ok := d.GetOk("install_rackd"); ok {
-		log.Printf("[INFO] Adding maas rack controller packages: %s", installRackD)
-		nodeParams.Add("install_rackd", strconv.FormatBool(true))
+	if instance.InstallRackD {
+		log.Printf("[INFO] Adding maas rack controller packages: %t", instance.InstallRackD)
 	}
 
-	if err = nodeDo(meta.(*Config).MAASObject, d.Id(), "deploy", nodeParams); err != nil {
+	machineManager, err := maas.NewMachineManager(d.Id(), gmaw.NewMachine(meta.(*Config).MAASObject))
+	if err == nil {
+		err = machineManager.Deploy(instance.DeployParams())
+	}
+	if err != nil {
 		log.Printf("[ERROR] [resourceMAASInstanceCreate] Unable to power up node: %s\n", d.Id())
 		// unable to perform action, release the node
-		if err = nodeRelease(meta.(*Config).MAASObject, d.Id(), url.Values{}); err != nil {
-			log.Printf("[DEBUG] Unable to release node")
-		}
+		releaseFailedNode(d, meta)
 		return err
 	}
 
@@ -93,51 +88,143 @@ func resourceMAASInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if _, err = stateConf.WaitForState(); err != nil {
-		if err = nodeRelease(meta.(*Config).MAASObject, d.Id(), url.Values{}); err != nil {
-			log.Printf("[DEBUG] Unable to release node")
-		}
-		return fmt.Errorf("[ERROR] [resourceMAASInstanceCreate] Error waiting for instance (%s) to become deployed: %s",
-			d.Id(), err)
+		// fetch the details before the release overwrites them
+		details := getDeployFailure(meta.(*Config).MAASObject, d.Id())
+		releaseFailedNode(d, meta)
+		return fmt.Errorf("[ERROR] [resourceMAASInstanceCreate] Error waiting for instance (%s) to become deployed: %s\n%s",
+			d.Id(), err, details)
 	}
 
-	// update node
-	params := url.Values{}
-	if hostname, ok := d.GetOk("deploy_hostname"); ok {
-		params.Add("hostname", hostname.(string))
-	}
+	// the deploy hostname, deploy tags and other metadata are applied by the update
+	return resourceMAASInstanceUpdate(d, meta)
+}
 
-	// only updating hostname
-	err = nodeUpdate(meta.(*Config).MAASObject, d.Id(), params)
-	if err != nil {
-		log.Println("[DEBUG] Unable to update node")
+// releaseFailedNode releases a node that failed to deploy, unless release_on_deploy_failure is false.
+// A node that is not released remains allocated so the failure can be investigated.
+func releaseFailedNode(d *schema.ResourceData, meta interface{}) {
+	if !d.Get("release_on_deploy_failure").(bool) {
+		log.Printf("[INFO] [releaseFailedNode] Leaving node (%s) allocated for debugging", d.Id())
+		return
 	}
-
-	// update node tags
-	if tags, ok := d.GetOk("deploy_tags"); ok {
-		for i := range tags.([]interface{}) {
-			err := nodeTagsUpdate(meta.(*Config).MAASObject, d.Id(), tags.([]interface{})[i].(string))
-			if err != nil {
-				log.Printf("[ERROR] Unable to update node (%s) with tag (%s)", d.Id(), tags.([]interface{})[i].(string))
-			}
-		}
+	if err := nodeRelease(meta.(*Config).MAASObject, d.Id(), url.Values{}); err != nil {
+		log.Printf("[DEBUG] Unable to release node")
+		return
 	}
-
-	return resourceMAASInstanceUpdate(d, meta)
+	removeKernelOptionsTag(d, meta)
 }
 
 // resourceMAASInstanceRead read instance information from a maas node
-// TODO: remove or do something
+// TODO: read the remaining attributes
 func resourceMAASInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Reading instance (%s) information.\n", d.Id())
-	return nil
+	machineManager, err := maas.NewMachineManager(d.Id(), gmaw.NewMachine(meta.(*Config).MAASObject))
+	if err != nil {
+		return err
+	}
+
+	if err := provider.ReadInstanceLock(d, machineManager.Current()); err != nil {
+		return err
+	}
+
+	// only the owner data keys managed by terraform are read, other tools may set their own,
+	// so keys added outside of terraform are not reported as drift
+	ownerData := make(map[string]string)
+	for key := range d.Get("owner_data").(map[string]interface{}) {
+		if val, ok := machineManager.Current().OwnerData[key]; ok {
+			ownerData[key] = val
+		}
+	}
+	return d.Set("owner_data", ownerData)
 }
 
 // resourceMAASInstanceUpdate update an instance in terraform state
 func resourceMAASInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] [resourceMAASInstanceUpdate] Modifying instance %s\n", d.Id())
 
+	machineManager, err := maas.NewMachineManager(d.Id(), gmaw.NewMachine(meta.(*Config).MAASObject))
+	if err != nil {
+		return err
+	}
+
 	d.Partial(true)
 
+	// update the machine in place, sending the attributes that changed even when they were removed,
+	// so that they are cleared. The hostname selects the machine to allocate, so only the deploy
+	// hostname renames it.
+	params := maas.MachineUpdateParams{}
+	changed := []string{}
+	for key, val := range map[string]**string{
+		"deploy_hostname": &params.Hostname,
+		"domain":          &params.Domain,
+		"description":     &params.Description,
+		"pool":            &params.Pool,
+		"min_hwe_kernel":  &params.MinHWEKernel,
+	} {
+		if d.HasChange(key) {
+			v := d.Get(key).(string)
+			*val = &v
+			changed = append(changed, key)
+		}
+	}
+	if d.HasChange("zone") {
+		// a machine is always in a zone, so removing the zone block leaves it where it is
+		for _, zone := range d.Get("zone").(*schema.Set).List() {
+			name := zone.(map[string]interface{})["name"].(string)
+			params.Zone = &name
+		}
+		changed = append(changed, "zone")
+	}
+	if len(changed) > 0 {
+		params.Comment = d.Get("comment").(string)
+		if err := machineManager.Put(params); err != nil {
+			return err
+		}
+		for _, key := range changed {
+			d.SetPartial(key)
+		}
+	}
+
+	// the comment is only recorded in the event log along with a change, so a new comment alone is not sent
+	d.SetPartial("comment")
+
+	// merge the owner data keys managed by terraform, clearing the ones that were removed
+	if d.HasChange("owner_data") {
+		oldData, newData := d.GetChange("owner_data")
+		data := make(map[string]string)
+		for key := range oldData.(map[string]interface{}) {
+			data[key] = ""
+		}
+		for key, val := range newData.(map[string]interface{}) {
+			data[key] = val.(string)
+		}
+		if err := machineManager.SetOwnerData(data); err != nil {
+			return err
+		}
+		d.SetPartial("owner_data")
+	}
+
+	// add and remove the deploy tags that changed
+	if d.HasChange("deploy_tags") {
+		oldTags, newTags := d.GetChange("deploy_tags")
+		for _, tag := range listDifference(newTags.([]interface{}), oldTags.([]interface{})) {
+			if err := nodeTagsUpdate(meta.(*Config).MAASObject, d.Id(), tag); err != nil {
+				return err
+			}
+		}
+		for _, tag := range listDifference(oldTags.([]interface{}), newTags.([]interface{})) {
+			if err := nodeTagsRemove(meta.(*Config).MAASObject, d.Id(), tag); err != nil {
+				return err
+			}
+		}
+		d.SetPartial("deploy_tags")
+	}
+
+	// lock or unlock the machine, leaving a lock placed outside of terraform in place
+	if err := provider.ReconcileInstanceLock(d, machineManager); err != nil {
+		return err
+	}
+	d.SetPartial("lock")
+
 	d.Partial(false)
 
 	log.Printf("[DEBUG] Done Modifying instance %s", d.Id())
@@ -148,6 +235,37 @@ func resourceMAASInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 // This function doesn't really *delete* a maas managed instance but releases (read, turns off) the node.
 func resourceMAASInstanceDelete(d *schema.ResourceData, meta interface{}) error { // nolint: funlen
 	log.Printf("[DEBUG] Deleting instance %s\n", d.Id())
+
+	// keep the node (and its disks, owner data and kernel options) for inspection instead of releasing it
+	if d.Get("rescue_on_destroy").(bool) {
+		return rescueNode(d, meta)
+	}
+
+	machineManager, err := maas.NewMachineManager(d.Id(), gmaw.NewMachine(meta.(*Config).MAASObject))
+	if err != nil {
+		return err
+	}
+
+	// a node put into rescue mode, eg by an earlier destroy, has to leave it before it can be released
+	if node.Status(machineManager.Current().Status) == node.StatusRescueMode {
+		log.Printf("[DEBUG] [resourceMAASInstanceDelete] Exiting rescue mode on node (%s)", d.Id())
+		if err := machineManager.ExitRescueMode(30 * time.Minute); err != nil { // nolint: gomnd
+			return fmt.Errorf(
+				"[ERROR] [resourceMAASInstanceDelete] Error waiting for instance (%s) to exit rescue mode: %s", d.Id(), err)
+		}
+	}
+
+	// clear the owner data keys managed by terraform
+	if ownerData := d.Get("owner_data").(map[string]interface{}); len(ownerData) > 0 {
+		data := make(map[string]string, len(ownerData))
+		for key := range ownerData {
+			data[key] = ""
+		}
+		if err := machineManager.SetOwnerData(data); err != nil {
+			return err
+		}
+	}
+
 	releaseParams := url.Values{}
 
 	if releaseErase, ok := d.GetOk("release_erase"); ok {
@@ -166,10 +284,18 @@ func resourceMAASInstanceDelete(d *schema.ResourceData, meta interface{}) error
 		releaseParams.Add("quick_erase", strconv.FormatBool(releaseEraseQuick.(bool)))
 	}
 
+	// a locked machine cannot be released, and only a lock placed by terraform is terraform's to remove
+	if err := provider.UnlockInstance(d, machineManager); err != nil {
+		return err
+	}
+
 	if err := nodeRelease(meta.(*Config).MAASObject, d.Id(), releaseParams); err != nil {
 		return err
 	}
 
+	// the node boots with the kernel options as long as it is deployed, so the tag goes with the release
+	removeKernelOptionsTag(d, meta)
+
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"6:", "12:", "14:"},
 		Target:     []string{"4:"},
@@ -210,3 +336,86 @@ func resourceMAASInstanceDelete(d *schema.ResourceData, meta interface{}) error
 
 	return nil
 }
+
+// removeKernelOptionsTag deletes the tag holding the kernel options of the deploy, if any.
+// It is only called once the node is released, since a deployed node boots with the options.
+func removeKernelOptionsTag(d *schema.ResourceData, meta interface{}) {
+	if _, ok := d.GetOk("kernel_options"); !ok {
+		return
+	}
+	if err := gmaw.NewMachine(meta.(*Config).MAASObject).UntagKernelOptions(d.Id()); err != nil {
+		log.Printf("[DEBUG] Unable to remove the kernel options tag: %s", err)
+	}
+}
+
+// rescueNode puts a node into rescue mode instead of releasing it.
+// The node remains allocated, and is removed from the Terraform state
+// so a replacement can be deployed while the node is inspected.
+func rescueNode(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] [rescueNode] Entering rescue mode on node (%s)", d.Id())
+	machineManager, err := maas.NewMachineManager(d.Id(), gmaw.NewMachine(meta.(*Config).MAASObject))
+	if err != nil {
+		return err
+	}
+	if err := machineManager.RescueMode(30 * time.Minute); err != nil { // nolint: gomnd
+		return fmt.Errorf("[ERROR] [rescueNode] Error waiting for instance (%s) to enter rescue mode: %s", d.Id(), err)
+	}
+
+	log.Printf("[INFO] [rescueNode] Node (%s) is in rescue mode and must be released manually", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// resourceMAASInstanceCustomizeDiff plans the lock or unlock of the machine when it does not match
+// the lock attribute, and checks at plan time that the distro_series and hwe_kernel
+// have been imported, rather than finding out when the deploy fails after the node is allocated.
+// When the osystem is set, the distro_series must be a release of that OS, eg the name of a custom image.
+func resourceMAASInstanceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := provider.InstanceLockCustomizeDiff(d); err != nil {
+		return err
+	}
+	if err := validateDeployOptions(d, meta); err != nil {
+		return err
+	}
+	if !d.HasChange("osystem") && !d.HasChange("distro_series") && !d.HasChange("hwe_kernel") {
+		return nil
+	}
+	if !d.NewValueKnown("osystem") || !d.NewValueKnown("distro_series") || !d.NewValueKnown("hwe_kernel") {
+		return nil
+	}
+	distroSeries, hweKernel := d.Get("distro_series").(string), d.Get("hwe_kernel").(string)
+	if distroSeries == "" && hweKernel == "" {
+		return nil
+	}
+	if osystem := d.Get("osystem").(string); osystem != "" && distroSeries != "" {
+		distroSeries = osystem + "/" + distroSeries
+	}
+
+	log.Println("[DEBUG] [resourceMAASInstanceCustomizeDiff] Checking the imported boot images")
+	resources, err := gmaw.NewBootResources(meta.(*Config).MAASObject).Get(nil)
+	if err != nil {
+		return err
+	}
+	return provider.ValidateBootImage(provider.NewBootImages(resources), distroSeries, hweKernel)
+}
+
+// validateDeployOptions checks at plan time that the MaaS server supports the deploy options that are
+// enabled, since older versions of MaaS ignore the options they do not know about.
+func validateDeployOptions(d *schema.ResourceDiff, meta interface{}) error {
+	var options []string
+	for _, option := range []string{"ephemeral_deploy", "enable_hw_sync"} {
+		if d.HasChange(option) && d.Get(option).(bool) {
+			options = append(options, option)
+		}
+	}
+	if len(options) == 0 {
+		return nil
+	}
+
+	log.Println("[DEBUG] [validateDeployOptions] Checking the MaaS version")
+	version, err := gmaw.NewVersion(meta.(*Config).MAASObject).Get()
+	if err != nil {
+		return err
+	}
+	return provider.ValidateDeployOptions(version.Version, options...)
+}
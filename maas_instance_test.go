@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jarcoal/httpmock"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity/node"
+)
+
+func TestResourceMAASInstanceCreate_DeployFailure(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	apiURL := "http://localhost:5240/MAAS/api/2.0"
+	client, err := gmaw.GetClient("http://localhost:5240/MAAS", "some:secret:key", "2.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	machine := `{"system_id": "g8xyqs", "hostname": "node-1", "power_state": "off", "cpu_count": 4,
+		"architecture": "amd64/generic", "distro_series": "", "memory": 8192, "osystem": "", "status": 10,
+		"tag_names": [], "resource_uri": "/MAAS/api/2.0/machines/g8xyqs/"}`
+	systemID, tag := "g8xyqs", gmaw.DeployKernelOptionsTag("g8xyqs")
+	httpmock.RegisterResponder("POST", apiURL+"/machines/?op=allocate",
+		httpmock.NewStringResponder(http.StatusOK, machine))
+	httpmock.RegisterResponder("GET", apiURL+"/machines/"+systemID+"/",
+		httpmock.NewStringResponder(http.StatusOK, machine))
+	httpmock.RegisterResponder("PUT", apiURL+"/tags/"+tag+"/",
+		httpmock.NewStringResponder(http.StatusOK, `{"name": "`+tag+`", "resource_uri": "/MAAS/api/2.0/tags/`+tag+`/"}`))
+	httpmock.RegisterResponder("POST", apiURL+"/tags/"+tag+"/?op=update_nodes",
+		httpmock.NewStringResponder(http.StatusOK, `{"added": 1, "removed": 0}`))
+	httpmock.RegisterResponder("POST", apiURL+"/machines/"+systemID+"/?op=deploy",
+		httpmock.NewStringResponder(http.StatusServiceUnavailable, "No IP addresses available"))
+	httpmock.RegisterResponder("POST", apiURL+"/machines/"+systemID+"/?op=release",
+		httpmock.NewStringResponder(http.StatusOK, machine))
+	httpmock.RegisterResponder("DELETE", apiURL+"/tags/"+tag+"/",
+		httpmock.NewStringResponder(http.StatusNoContent, ""))
+
+	d := schema.TestResourceDataRaw(t, resourceMAASInstance().Schema, map[string]interface{}{
+		"kernel_options": "isolcpus=2-15",
+	})
+	err = resourceMAASInstanceCreate(d, &Config{MAASObject: client})
+	if err == nil || !strings.Contains(err.Error(), "No IP addresses available") {
+		t.Fatalf("Expected the deploy error, got %v", err)
+	}
+
+	// The machine is released, and the kernel options tag does not outlive the deploy
+	info := httpmock.GetCallCountInfo()
+	if n := info["POST "+apiURL+"/machines/"+systemID+"/?op=release"]; n != 1 {
+		t.Errorf("machine released %d times, want 1", n)
+	}
+	if n := info["DELETE "+apiURL+"/tags/"+tag+"/"]; n == 0 {
+		t.Error("kernel options tag not deleted")
+	}
+}
+
+func TestResourceMAASInstanceDelete_Rescue(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	defer func(interval time.Duration) { maas.MachinePollInterval = interval }(maas.MachinePollInterval)
+	maas.MachinePollInterval = time.Millisecond
+	apiURL := "http://localhost:5240/MAAS/api/2.0"
+	client, err := gmaw.GetClient("http://localhost:5240/MAAS", "some:secret:key", "2.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	systemID, status := "g8xyqs", node.StatusDeployed
+	machine := func() string {
+		return fmt.Sprintf(`{"system_id": "%s", "status": %d, "owner_data": {"service": "web"},
+			"resource_uri": "/MAAS/api/2.0/machines/%s/"}`, systemID, status, systemID)
+	}
+	httpmock.RegisterResponder("GET", apiURL+"/machines/"+systemID+"/",
+		func(*http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, machine()), nil
+		})
+	httpmock.RegisterResponder("POST", apiURL+"/machines/"+systemID+"/?op=rescue_mode",
+		func(*http.Request) (*http.Response, error) {
+			status = node.StatusRescueMode
+			return httpmock.NewStringResponse(http.StatusOK, machine()), nil
+		})
+
+	d := schema.TestResourceDataRaw(t, resourceMAASInstance().Schema, map[string]interface{}{
+		"rescue_on_destroy": true,
+		"kernel_options":    "isolcpus=2-15",
+		"owner_data":        map[string]interface{}{"service": "web"},
+	})
+	d.SetId(systemID)
+	if err := resourceMAASInstanceDelete(d, &Config{MAASObject: client}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The node is kept as it is for inspection, along with its owner data and kernel options tag
+	for call, n := range httpmock.GetCallCountInfo() {
+		if n > 0 && call != "GET "+apiURL+"/machines/"+systemID+"/" &&
+			call != "POST "+apiURL+"/machines/"+systemID+"/?op=rescue_mode" {
+			t.Errorf("unexpected request %s", call)
+		}
+	}
+	if d.Id() != "" {
+		t.Errorf("Expected the instance to be removed from the state, got ID %s", d.Id())
+	}
+}
@@ -17,6 +17,8 @@ func resourceMAASInstance() *schema.Resource { // nolint: funlen
 		Update: resourceMAASInstanceUpdate,
 		Delete: resourceMAASInstanceDelete,
 
+		CustomizeDiff: resourceMAASInstanceCustomizeDiff,
+
 		SchemaVersion: 1, // nolint: gomnd
 
 		Schema: map[string]*schema.Schema{
@@ -58,13 +60,11 @@ func resourceMAASInstance() *schema.Resource { // nolint: funlen
 			"deploy_hostname": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 
 			"deploy_tags": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
@@ -110,6 +110,38 @@ func resourceMAASInstance() *schema.Resource { // nolint: funlen
 				Default:  false,
 			},
 
+			"ephemeral_deploy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"enable_hw_sync": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"kernel_options": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"release_on_deploy_failure": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: false,
+				Default:  true,
+			},
+			"rescue_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: false,
+				Default:  false,
+			},
+
 			"ip_addresses": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -155,6 +187,13 @@ func resourceMAASInstance() *schema.Resource { // nolint: funlen
 				ForceNew: true,
 			},
 
+			"license_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
 			"owner": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -255,6 +294,7 @@ func resourceMAASInstance() *schema.Resource { // nolint: funlen
 			"storage": {
 				Type:     schema.TypeInt,
 				Optional: true,
+				ForceNew: true,
 			},
 
 			"swap_size": {
@@ -320,6 +360,43 @@ func resourceMAASInstance() *schema.Resource { // nolint: funlen
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"domain": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"pool": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"min_hwe_kernel": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"owner_data": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"lock": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"locked": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"locked_by_terraform": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
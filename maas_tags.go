@@ -2,18 +2,18 @@ package main
 
 import (
 	"log"
-	"net/url"
+	"net/http"
 
 	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/gmaw"
 )
 
 // tagCreate creates a new tag
 func tagCreate(maas *gomaasapi.MAASObject, tagName string) error {
 	log.Printf("[DEBUG] [tagCreate] Creating new tag named %s", tagName)
 
-	params := url.Values{}
-	params.Set("name", tagName)
-	_, err := maas.GetSubObject("tags").CallPost("", params)
+	_, err := gmaw.NewTags(maas).Post(&params.Tag{Name: tagName})
 	return err
 }
 
@@ -22,23 +22,19 @@ func nodeTagsUpdate(maas *gomaasapi.MAASObject, systemID, tagName string) error
 	log.Println("[DEBUG] [nodeUpdate] Attempting to update a node's tags")
 
 	// make sure tag exists
-	tagObject, err := maas.GetSubObject("tags").GetSubObject(tagName).Get()
-	if err != nil {
-		// create tag if it doesn't exist
-		log.Printf("[ERROR] [nodeTagsUpdate] Tag %s does not exist", tagName)
-		err = tagCreate(maas, tagName)
-		if err != nil {
+	if _, err := gmaw.NewTag(maas).Get(tagName); err != nil {
+		if serverErr, ok := gomaasapi.GetServerError(err); !ok || serverErr.StatusCode != http.StatusNotFound {
+			log.Printf("[ERROR] [nodeTagsUpdate] Unable to get tag (%s).  Failed with error (%s)\n", tagName, err)
 			return err
 		}
-		tagObject, err = maas.GetSubObject("tags").GetSubObject(tagName).Get()
-		if err != nil {
-			return nil
+		// create tag if it doesn't exist
+		log.Printf("[DEBUG] [nodeTagsUpdate] Tag %s does not exist", tagName)
+		if err := tagCreate(maas, tagName); err != nil {
+			return err
 		}
 	}
 
-	params := url.Values{}
-	params.Set("add", systemID)
-	_, err = tagObject.CallPost("update_nodes", params)
+	_, _, err := gmaw.NewTag(maas).UpdateNodes(tagName, &params.TagUpdateNodes{Add: []string{systemID}})
 	if err != nil {
 		log.Printf("[ERROR] [nodeTagsUpdate] Unable to update node (%s) tag (%s).  Failed with error (%s)\n",
 			systemID, tagName, err)
@@ -51,17 +47,9 @@ func nodeTagsUpdate(maas *gomaasapi.MAASObject, systemID, tagName string) error
 func nodeTagsRemove(maas *gomaasapi.MAASObject, systemID, tagName string) error {
 	log.Println("[DEBUG] [nodeUpdate] Attempting to remove a node's tag")
 
-	// make sure tag exists
-	tagObject, err := maas.GetSubObject("tags").GetSubObject(tagName).Get()
-	if err != nil {
-		return err
-	}
-
-	params := url.Values{}
-	params.Set("remove", systemID)
-	_, err = tagObject.CallPost("update_nodes", params)
+	_, _, err := gmaw.NewTag(maas).UpdateNodes(tagName, &params.TagUpdateNodes{Remove: []string{systemID}})
 	if err != nil {
-		log.Printf("[ERROR] [nodeTagsUpdate] Unable to update node (%s) tag (%s).  Failed with error (%s)\n",
+		log.Printf("[ERROR] [nodeTagsRemove] Unable to update node (%s) tag (%s).  Failed with error (%s)\n",
 			systemID, tagName, err)
 		return err
 	}
@@ -0,0 +1,11 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// BootResource represents the MaaS BootResource endpoint
+type BootResource interface {
+	Delete(id int) error
+	Get(id int) (*entity.BootResource, error)
+}
@@ -0,0 +1,14 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// BootResources represents the MaaS BootResources endpoint
+type BootResources interface {
+	Get(params *params.BootResourcesRead) ([]entity.BootResource, error)
+	Import() error
+	IsImporting() (bool, error)
+	StopImport() error
+}
@@ -0,0 +1,13 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// BootSource represents the MaaS BootSource endpoint
+type BootSource interface {
+	Delete(id int) error
+	Get(id int) (*entity.BootSource, error)
+	Put(id int, params *params.BootSource) (*entity.BootSource, error)
+}
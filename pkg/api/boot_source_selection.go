@@ -0,0 +1,13 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// BootSourceSelection represents the MaaS BootSourceSelection endpoint
+type BootSourceSelection interface {
+	Delete(bootSourceID int, id int) error
+	Get(bootSourceID int, id int) (*entity.BootSourceSelection, error)
+	Put(bootSourceID int, id int, params *params.BootSourceSelection) (*entity.BootSourceSelection, error)
+}
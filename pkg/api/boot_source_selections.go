@@ -0,0 +1,12 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// BootSourceSelections represents the MaaS BootSourceSelections endpoint
+type BootSourceSelections interface {
+	Get(bootSourceID int) ([]entity.BootSourceSelection, error)
+	Post(bootSourceID int, params *params.BootSourceSelection) (*entity.BootSourceSelection, error)
+}
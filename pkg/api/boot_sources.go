@@ -0,0 +1,12 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// BootSources represents the MaaS BootSources endpoint
+type BootSources interface {
+	Get() ([]entity.BootSource, error)
+	Post(*params.BootSource) (*entity.BootSource, error)
+}
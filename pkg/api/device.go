@@ -0,0 +1,13 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Device represents the MaaS Device endpoint
+type Device interface {
+	Delete(systemID string) error
+	Get(systemID string) (*entity.Device, error)
+	Put(systemID string, params *params.Device) (*entity.Device, error)
+}
@@ -0,0 +1,12 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Devices represents the MaaS Devices endpoint
+type Devices interface {
+	Get() ([]entity.Device, error)
+	Post(*params.Device) (*entity.Device, error)
+}
@@ -0,0 +1,13 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// DHCPSnippet represents the MaaS DHCP Snippet endpoint
+type DHCPSnippet interface {
+	Delete(id int) error
+	Get(id int) (*entity.DHCPSnippet, error)
+	Put(id int, params *params.DHCPSnippet) (*entity.DHCPSnippet, error)
+}
@@ -0,0 +1,12 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// DHCPSnippets represents the MaaS DHCP Snippets endpoint
+type DHCPSnippets interface {
+	Get() ([]entity.DHCPSnippet, error)
+	Post(*params.DHCPSnippet) (*entity.DHCPSnippet, error)
+}
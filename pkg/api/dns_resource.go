@@ -0,0 +1,13 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// DNSResource represents the MaaS DNSResource endpoint
+type DNSResource interface {
+	Delete(id int) error
+	Get(id int) (*entity.DNSResource, error)
+	Put(id int, params *params.DNSResource) (*entity.DNSResource, error)
+}
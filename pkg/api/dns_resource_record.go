@@ -0,0 +1,13 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// DNSResourceRecord represents the MaaS DNSResourceRecord endpoint
+type DNSResourceRecord interface {
+	Delete(id int) error
+	Get(id int) (*entity.DNSResourceRecord, error)
+	Put(id int, params *params.DNSResourceRecord) (*entity.DNSResourceRecord, error)
+}
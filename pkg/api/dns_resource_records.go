@@ -0,0 +1,12 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// DNSResourceRecords represents the MaaS DNSResourceRecords endpoint
+type DNSResourceRecords interface {
+	Get() ([]entity.DNSResourceRecord, error)
+	Post(*params.DNSResourceRecord) (*entity.DNSResourceRecord, error)
+}
@@ -0,0 +1,12 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// DNSResources represents the MaaS DNSResources endpoint
+type DNSResources interface {
+	Get() ([]entity.DNSResource, error)
+	Post(*params.DNSResource) (*entity.DNSResource, error)
+}
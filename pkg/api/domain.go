@@ -0,0 +1,14 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Domain represents the MaaS Domain endpoint
+type Domain interface {
+	Delete(id int) error
+	Get(id int) (*entity.Domain, error)
+	Put(id int, params *params.Domain) (*entity.Domain, error)
+	SetDefault(id int) (*entity.Domain, error)
+}
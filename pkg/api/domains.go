@@ -0,0 +1,12 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Domains represents the MaaS Domains endpoint
+type Domains interface {
+	Get() ([]entity.Domain, error)
+	Post(*params.Domain) (*entity.Domain, error)
+}
@@ -0,0 +1,13 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// LicenseKey represents the MaaS License Key endpoint
+type LicenseKey interface {
+	Delete(osystem, distroSeries string) error
+	Get(osystem, distroSeries string) (*entity.LicenseKey, error)
+	Put(osystem, distroSeries string, params *params.LicenseKey) (*entity.LicenseKey, error)
+}
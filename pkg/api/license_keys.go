@@ -0,0 +1,12 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// LicenseKeys represents the MaaS License Keys endpoint
+type LicenseKeys interface {
+	Get() ([]entity.LicenseKey, error)
+	Post(*params.LicenseKey) (*entity.LicenseKey, error)
+}
@@ -0,0 +1,12 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// NodeResult represents the MaaS Node Script Result endpoint
+type NodeResult interface {
+	Delete(systemID string, id int) error
+	Get(systemID string, id int, params *params.NodeResultSearch) (*entity.NodeResult, error)
+}
@@ -0,0 +1,11 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// NodeResults represents the MaaS Node Script Results endpoint
+type NodeResults interface {
+	Get(systemID string, params *params.NodeResultSearch) ([]entity.NodeResult, error)
+}
@@ -0,0 +1,12 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// PackageRepositories represents the MaaS Package Repositories endpoint
+type PackageRepositories interface {
+	Get() ([]entity.PackageRepository, error)
+	Post(*params.PackageRepository) (*entity.PackageRepository, error)
+}
@@ -0,0 +1,13 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// PackageRepository represents the MaaS Package Repository endpoint
+type PackageRepository interface {
+	Delete(id int) error
+	Get(id int) (*entity.PackageRepository, error)
+	Put(id int, params *params.PackageRepository) (*entity.PackageRepository, error)
+}
@@ -0,0 +1,7 @@
+package params
+
+// BootResourcesRead contains the parameters for the GET operation on the BootResources endpoint.
+// Type is one of synced, generated or uploaded; all of the boot resources are returned without it.
+type BootResourcesRead struct {
+	Type string `json:"type,omitempty"`
+}
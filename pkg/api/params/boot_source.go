@@ -0,0 +1,21 @@
+package params
+
+// BootSource contains the parameters for the POST operation on the BootSources
+// endpoint and the PUT operation on the BootSource endpoint.
+// KeyringData is the content of a keyring file. It is uploaded as a file when
+// the boot source is created, and sent as a string when it is updated.
+type BootSource struct {
+	URL             string `json:"url,omitempty"`
+	KeyringFilename string `json:"keyring_filename,omitempty"`
+	KeyringData     []byte `json:"keyring_data,omitempty"`
+}
+
+// BootSourceSelection contains the parameters for the POST operation on the
+// BootSourceSelections endpoint and the PUT operation on the BootSourceSelection endpoint.
+type BootSourceSelection struct {
+	OS        string   `json:"os,omitempty"`
+	Release   string   `json:"release,omitempty"`
+	Arches    []string `json:"arches,omitempty"`
+	Subarches []string `json:"subarches,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+}
@@ -0,0 +1,13 @@
+package params
+
+// Device contains the parameters for the POST operation on the Devices endpoint
+// and the PUT operation on the Device endpoint. MACAddresses is only used when
+// the device is created. Parent is the system ID of the node the device belongs to,
+// if any; the device is removed along with its parent.
+type Device struct {
+	Hostname     string   `json:"hostname,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	Domain       string   `json:"domain,omitempty"`
+	Parent       string   `json:"parent,omitempty"`
+	MACAddresses []string `json:"mac_addresses,omitempty"`
+}
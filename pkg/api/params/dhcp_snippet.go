@@ -0,0 +1,14 @@
+package params
+
+// DHCPSnippet contains the parameters for the POST operation on the DHCPSnippets endpoint
+// and the PUT operation on the DHCPSnippet endpoint. At most one of Node (a system ID),
+// Subnet (an ID) and GlobalSnippet should be set.
+type DHCPSnippet struct {
+	Name          string `json:"name,omitempty"`
+	Value         string `json:"value,omitempty"`
+	Description   string `json:"description,omitempty"`
+	Node          string `json:"node,omitempty"`
+	Subnet        int    `json:"subnet,omitempty"`
+	Enabled       bool   `json:"enabled,omitempty"`
+	GlobalSnippet bool   `json:"global_snippet,omitempty"`
+}
@@ -0,0 +1,24 @@
+package params
+
+// DNSResource contains the parameters for the POST operation on the DNSResources
+// endpoint and the PUT operation on the DNSResource endpoint. Either FQDN or
+// Name and Domain identify the resource.
+type DNSResource struct {
+	IPAddresses []string `json:"ip_addresses,omitempty"`
+	FQDN        string   `json:"fqdn,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Domain      string   `json:"domain,omitempty"`
+	AddressTTL  int      `json:"address_ttl,omitempty"`
+}
+
+// DNSResourceRecord contains the parameters for the POST operation on the
+// DNSResourceRecords endpoint and the PUT operation on the DNSResourceRecord
+// endpoint. Either FQDN or Name and Domain identify the record.
+type DNSResourceRecord struct {
+	FQDN   string `json:"fqdn,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Domain string `json:"domain,omitempty"`
+	RRType string `json:"rrtype,omitempty"`
+	RRData string `json:"rrdata,omitempty"`
+	TTL    int    `json:"ttl,omitempty"`
+}
@@ -0,0 +1,9 @@
+package params
+
+// Domain contains the parameters for the POST operation on the Domains endpoint
+// and the PUT operation on the Domain endpoint.
+type Domain struct {
+	Name          string `json:"name,omitempty"`
+	TTL           int    `json:"ttl,omitempty"`
+	Authoritative bool   `json:"authoritative,omitempty"`
+}
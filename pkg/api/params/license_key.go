@@ -0,0 +1,10 @@
+package params
+
+// LicenseKey contains the parameters for the POST operation on the LicenseKeys endpoint
+// and the PUT operation on the LicenseKey endpoint. The PUT operation only uses the
+// LicenseKey field, since the OS release of a license key cannot be changed.
+type LicenseKey struct {
+	OSystem      string `json:"osystem,omitempty"`
+	DistroSeries string `json:"distro_series,omitempty"`
+	LicenseKey   string `json:"license_key,omitempty"`
+}
@@ -0,0 +1,14 @@
+package params
+
+// NodeResultSearch narrows down the results returned by NodeResults.Get() and
+// NodeResult.Get(). All fields are optional. Type must be one of (commissioning,
+// testing, installation) and HardwareType one of (node, cpu, memory, storage).
+// The script output is only included in the response if IncludeOutput is set,
+// and Filters limits the results to the named scripts or script tags.
+// NodeResult.Get() ignores the Type and HardwareType fields.
+type NodeResultSearch struct {
+	Type          string   `json:"type,omitempty"`
+	HardwareType  string   `json:"hardware_type,omitempty"`
+	IncludeOutput bool     `json:"include_output,omitempty"`
+	Filters       []string `json:"filters,omitempty"`
+}
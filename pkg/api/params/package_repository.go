@@ -0,0 +1,18 @@
+package params
+
+// PackageRepository contains the parameters for the POST operation on the PackageRepositories
+// endpoint and the PUT operation on the PackageRepository endpoint. URL is either the URL of
+// an APT repository or a PPA (ppa:owner/name), and Key is its ASCII-armored signing key.
+// DisabledPockets is a subset of (updates, security, backports).
+type PackageRepository struct {
+	Name               string   `json:"name,omitempty"`
+	URL                string   `json:"url,omitempty"`
+	Key                string   `json:"key,omitempty"`
+	Distributions      []string `json:"distributions,omitempty"`
+	DisabledPockets    []string `json:"disabled_pockets,omitempty"`
+	DisabledComponents []string `json:"disabled_components,omitempty"`
+	Components         []string `json:"components,omitempty"`
+	Arches             []string `json:"arches,omitempty"`
+	DisableSources     bool     `json:"disable_sources,omitempty"`
+	Enabled            bool     `json:"enabled,omitempty"`
+}
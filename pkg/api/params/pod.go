@@ -0,0 +1,47 @@
+package params
+
+// Pod contains the parameters for the POST operation on the Pods endpoint
+// and the PUT operation on the Pod endpoint.
+type Pod struct {
+	Tags                  []string `json:"tags,omitempty"`
+	Type                  string   `json:"type,omitempty"`
+	PowerAddress          string   `json:"power_address,omitempty"`
+	PowerPass             string   `json:"power_pass,omitempty"`
+	Name                  string   `json:"name,omitempty"`
+	Zone                  string   `json:"zone,omitempty"`
+	Pool                  string   `json:"pool,omitempty"`
+	DefaultStoragePool    string   `json:"default_storage_pool,omitempty"`
+	DefaultMACVLANMode    string   `json:"default_macvlan_mode,omitempty"`
+	CPUOverCommitRatio    float64  `json:"cpu_over_commit_ratio,omitempty"`
+	MemoryOverCommitRatio float64  `json:"memory_over_commit_ratio,omitempty"`
+}
+
+// PodCompose contains the parameters for the compose operation on the Pod endpoint.
+// The first disk is the boot disk.
+type PodCompose struct {
+	Disks        []PodComposeDisk      `json:"storage,omitempty"`
+	Interfaces   []PodComposeInterface `json:"interfaces,omitempty"`
+	Hostname     string                `json:"hostname,omitempty"`
+	Domain       string                `json:"domain,omitempty"`
+	Zone         string                `json:"zone,omitempty"`
+	Pool         string                `json:"pool,omitempty"`
+	Architecture string                `json:"architecture,omitempty"`
+	Cores        int                   `json:"cores,omitempty"`
+	Memory       int                   `json:"memory,omitempty"`
+}
+
+// PodComposeDisk is a disk of a composed machine. Size is in gigabytes,
+// and the disk is allocated from the default storage pool if Pool is empty.
+type PodComposeDisk struct {
+	Pool string `json:"pool,omitempty"`
+	Size int    `json:"size,omitempty"`
+}
+
+// PodComposeInterface is a network interface of a composed machine.
+// It is attached to the subnet or space it names, optionally with a static IP address.
+type PodComposeInterface struct {
+	Name       string `json:"name,omitempty"`
+	SubnetCIDR string `json:"subnet_cidr,omitempty"`
+	Space      string `json:"space,omitempty"`
+	IPAddress  string `json:"ip,omitempty"`
+}
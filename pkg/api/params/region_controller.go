@@ -0,0 +1,5 @@
+package params
+
+// RegionControllerSearch narrows down the list in RegionControllers.Get().
+// It takes the same criteria as RackControllerSearch, and all fields are optional.
+type RegionControllerSearch RackControllerSearch
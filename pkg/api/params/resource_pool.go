@@ -0,0 +1,8 @@
+package params
+
+// ResourcePool contains the parameters for the POST operation on the ResourcePools
+// endpoint and the PUT operation on the ResourcePool endpoint.
+type ResourcePool struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
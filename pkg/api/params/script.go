@@ -0,0 +1,36 @@
+package params
+
+// Script contains the parameters for the POST operation on the Scripts endpoint
+// and the PUT operation on the Script endpoint. Script is the body of the script,
+// which may embed its own metadata. Type must be one of (commissioning, testing),
+// HardwareType one of (node, cpu, memory, storage, network), and Parallel one of
+// (disabled, instance, any). Timeout is in seconds, with 0 meaning no timeout.
+// ForHardware limits the script to machines with the given hardware, such as
+// "pci:8086:1572" or "system_vendor:Dell Inc.". Comment describes the revision
+// of the script and is stored in its history.
+type Script struct {
+	Script       []byte   `json:"script,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+	ForHardware  []string `json:"for_hardware,omitempty"`
+	Name         string   `json:"name,omitempty"`
+	Title        string   `json:"title,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	Type         string   `json:"type,omitempty"`
+	HardwareType string   `json:"hardware_type,omitempty"`
+	Parallel     string   `json:"parallel,omitempty"`
+	Comment      string   `json:"comment,omitempty"`
+	Timeout      int      `json:"timeout,omitempty"`
+	Destructive  bool     `json:"destructive,omitempty"`
+	MayReboot    bool     `json:"may_reboot,omitempty"`
+}
+
+// ScriptSearch narrows down the scripts returned by Scripts.Get(). All fields
+// are optional. Type and HardwareType take the same values as in Script, and
+// Filters limits the scripts to the given tags. The body of each revision of
+// the scripts is only included in the response if IncludeScript is set.
+type ScriptSearch struct {
+	Filters       []string `json:"filters,omitempty"`
+	Type          string   `json:"type,omitempty"`
+	HardwareType  string   `json:"hardware_type,omitempty"`
+	IncludeScript bool     `json:"include_script,omitempty"`
+}
@@ -0,0 +1,17 @@
+package params
+
+// Tag contains the parameters for the POST operation on the Tags endpoint
+// and the PUT operation on the Tag endpoint.
+type Tag struct {
+	Name       string `json:"name,omitempty"`
+	Comment    string `json:"comment,omitempty"`
+	Definition string `json:"definition,omitempty"`
+	KernelOpts string `json:"kernel_opts,omitempty"`
+}
+
+// TagUpdateNodes contains the parameters for the update_nodes operation on the Tag endpoint.
+type TagUpdateNodes struct {
+	Add            []string `json:"add,omitempty"`
+	Remove         []string `json:"remove,omitempty"`
+	RackController string   `json:"rack_controller,omitempty"`
+}
@@ -0,0 +1,9 @@
+package params
+
+// User contains the parameters for the POST operation on the Users endpoint.
+type User struct {
+	Username    string `json:"username,omitempty"`
+	Email       string `json:"email,omitempty"`
+	Password    string `json:"password,omitempty"`
+	IsSuperuser bool   `json:"is_superuser,omitempty"`
+}
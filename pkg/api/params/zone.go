@@ -0,0 +1,8 @@
+package params
+
+// Zone contains the parameters for the POST operation on the Zones endpoint
+// and the PUT operation on the Zone endpoint.
+type Zone struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
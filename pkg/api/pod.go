@@ -0,0 +1,16 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Pod represents the MaaS Pod endpoint
+type Pod interface {
+	Compose(id int, params *params.PodCompose) (*entity.Machine, error)
+	Delete(id int) error
+	Get(id int) (*entity.Pod, error)
+	GetParameters(id int) (map[string]interface{}, error)
+	Put(id int, params *params.Pod) (*entity.Pod, error)
+	Refresh(id int) (*entity.Pod, error)
+}
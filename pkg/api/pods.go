@@ -0,0 +1,12 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Pods represents the MaaS Pods endpoint
+type Pods interface {
+	Get() ([]entity.Pod, error)
+	Post(*params.Pod) (*entity.Pod, error)
+}
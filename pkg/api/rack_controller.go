@@ -0,0 +1,12 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// RackController represents the MaaS Rack Controller endpoint
+type RackController interface {
+	Get(systemID string) (*entity.RackController, error)
+	ImportBootImages(systemID string) error
+	ListBootImages(systemID string) (*entity.RackControllerBootImages, error)
+}
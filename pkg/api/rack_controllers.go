@@ -7,5 +7,7 @@ import (
 
 // RackControllers represents the MaaS Rack Controllers endpoint
 type RackControllers interface {
+	DescribePowerTypes() ([]entity.PowerType, error)
 	Get(*params.RackControllerSearch) ([]entity.RackController, error)
+	ImportBootImages() error
 }
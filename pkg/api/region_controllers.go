@@ -0,0 +1,11 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// RegionControllers represents the MaaS Region Controllers endpoint
+type RegionControllers interface {
+	Get(*params.RegionControllerSearch) ([]entity.RegionController, error)
+}
@@ -0,0 +1,13 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// ResourcePool represents the MaaS ResourcePool endpoint
+type ResourcePool interface {
+	Delete(id int) error
+	Get(id int) (*entity.ResourcePool, error)
+	Put(id int, params *params.ResourcePool) (*entity.ResourcePool, error)
+}
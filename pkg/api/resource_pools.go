@@ -0,0 +1,12 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// ResourcePools represents the MaaS ResourcePools endpoint
+type ResourcePools interface {
+	Get() ([]entity.ResourcePool, error)
+	Post(*params.ResourcePool) (*entity.ResourcePool, error)
+}
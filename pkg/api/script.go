@@ -0,0 +1,15 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Script represents the MaaS Script endpoint
+type Script interface {
+	Delete(name string) error
+	Download(name string) ([]byte, error)
+	Get(name string) (*entity.Script, error)
+	Put(name string, params *params.Script) (*entity.Script, error)
+	Revert(name string, to int) (*entity.Script, error)
+}
@@ -0,0 +1,12 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Scripts represents the MaaS Scripts endpoint
+type Scripts interface {
+	Get(*params.ScriptSearch) ([]entity.Script, error)
+	Post(*params.Script) (*entity.Script, error)
+}
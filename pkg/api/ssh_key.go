@@ -0,0 +1,11 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// SSHKey represents the MaaS SSH Key endpoint
+type SSHKey interface {
+	Delete(id int) error
+	Get(id int) (*entity.SSHKey, error)
+}
@@ -0,0 +1,12 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// SSHKeys represents the MaaS SSH Keys endpoint
+type SSHKeys interface {
+	Get() ([]entity.SSHKey, error)
+	Post(key string) (*entity.SSHKey, error)
+	Import(keySource string) ([]entity.SSHKey, error)
+}
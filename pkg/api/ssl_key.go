@@ -0,0 +1,11 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// SSLKey represents the MaaS SSL Key endpoint
+type SSLKey interface {
+	Delete(id int) error
+	Get(id int) (*entity.SSLKey, error)
+}
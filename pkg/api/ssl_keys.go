@@ -0,0 +1,11 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// SSLKeys represents the MaaS SSL Keys endpoint
+type SSLKeys interface {
+	Get() ([]entity.SSLKey, error)
+	Post(key string) (*entity.SSLKey, error)
+}
@@ -0,0 +1,15 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Tag represents the MaaS Tag endpoint
+type Tag interface {
+	Delete(name string) error
+	Get(name string) (*entity.Tag, error)
+	GetNodes(name string) ([]entity.Node, error)
+	Put(name string, params *params.Tag) (*entity.Tag, error)
+	UpdateNodes(name string, params *params.TagUpdateNodes) (added, removed int, err error)
+}
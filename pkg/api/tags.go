@@ -0,0 +1,12 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Tags represents the MaaS Tags endpoint
+type Tags interface {
+	Get() ([]entity.Tag, error)
+	Post(*params.Tag) (*entity.Tag, error)
+}
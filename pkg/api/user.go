@@ -0,0 +1,11 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// User represents the MaaS User endpoint
+type User interface {
+	Delete(username string) error
+	Get(username string) (*entity.User, error)
+}
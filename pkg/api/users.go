@@ -0,0 +1,13 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Users represents the MaaS Users endpoint
+type Users interface {
+	Get() ([]entity.User, error)
+	Post(*params.User) (*entity.User, error)
+	WhoAmI() (*entity.User, error)
+}
@@ -0,0 +1,10 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Version represents the MaaS Version endpoint
+type Version interface {
+	Get() (*entity.Version, error)
+}
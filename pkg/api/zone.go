@@ -0,0 +1,13 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Zone represents the MaaS Zone endpoint
+type Zone interface {
+	Delete(name string) error
+	Get(name string) (*entity.Zone, error)
+	Put(name string, params *params.Zone) (*entity.Zone, error)
+}
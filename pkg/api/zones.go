@@ -0,0 +1,12 @@
+package api
+
+import (
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Zones represents the MaaS Zones endpoint
+type Zones interface {
+	Get() ([]entity.Zone, error)
+	Post(*params.Zone) (*entity.Zone, error)
+}
@@ -0,0 +1,45 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// BootResource provides methods for the BootResource operations in the MaaS API.
+// This type should be instantiated via NewBootResource(). It fulfills the
+// api.BootResource interface.
+type BootResource struct {
+	c Client
+}
+
+// NewBootResource configures a new BootResource.
+func NewBootResource(client *gomaasapi.MAASObject) *BootResource {
+	c := client.GetSubObject("boot-resources")
+	return &BootResource{c: Client{&c}}
+}
+
+// client returns a Client (ie wrapped MAASOBject) for the boot resource with the given ID
+func (b *BootResource) client(id int) Client {
+	return b.c.GetSubObject(strconv.Itoa(id))
+}
+
+// Delete removes a boot resource.
+// This function returns an error if the gomaasapi returns an error.
+func (b *BootResource) Delete(id int) error {
+	return b.client(id).Delete()
+}
+
+// Get returns information about a boot resource, including its sets.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (b *BootResource) Get(id int) (resource *entity.BootResource, err error) {
+	resource = new(entity.BootResource)
+	err = b.client(id).Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, resource)
+	})
+	return
+}
@@ -0,0 +1,59 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// BootResources provides methods for the BootResources operations in the MaaS API.
+// This type should be instantiated via NewBootResources(). It fulfills the
+// api.BootResources interface.
+type BootResources struct {
+	client Client
+}
+
+// NewBootResources configures a new BootResources.
+func NewBootResources(client *gomaasapi.MAASObject) *BootResources {
+	c := client.GetSubObject("boot-resources")
+	return &BootResources{client: Client{&c}}
+}
+
+// Get returns information about the boot resources, narrowed down by type.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (b *BootResources) Get(p *params.BootResourcesRead) (resources []entity.BootResource, err error) {
+	qsp := url.Values{}
+	if p != nil && p.Type != "" {
+		qsp.Set("type", p.Type)
+	}
+	err = b.client.Get("", qsp, func(data []byte) error {
+		return json.Unmarshal(data, &resources)
+	})
+	return
+}
+
+// Import starts importing the boot resources selected from the boot sources.
+// This function returns an error if the gomaasapi returns an error.
+func (b *BootResources) Import() error {
+	return b.client.Post("import", url.Values{}, func([]byte) error { return nil })
+}
+
+// IsImporting returns whether the boot resources are currently being imported.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (b *BootResources) IsImporting() (importing bool, err error) {
+	err = b.client.Get("is_importing", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, &importing)
+	})
+	return
+}
+
+// StopImport stops importing the boot resources.
+// This function returns an error if the gomaasapi returns an error.
+func (b *BootResources) StopImport() error {
+	return b.client.Post("stop_import", url.Values{}, func([]byte) error { return nil })
+}
@@ -0,0 +1,73 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewBootResources(t *testing.T) {
+	NewBootResources(client)
+}
+
+func TestBootResources(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.BootResources = (*BootResources)(nil)
+
+	// Create a new boot resources client to be used in the tests
+	resourcesClient := NewBootResources(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		var resources []entity.BootResource
+		if err := helper.TestdataFromJSON("maas/boot_resources.json", &resources); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/boot-resources/?type=synced",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, resources))
+		res, err := resourcesClient.Get(&params.BootResourcesRead{Type: "synced"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(resources, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(BootResources) mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("Import", func(t *testing.T) {
+		t.Parallel()
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/boot-resources/?op=import",
+			httpmock.NewStringResponder(http.StatusOK, "Import of boot resources started"))
+		if err := resourcesClient.Import(); err != nil {
+			t.Fatal(err)
+		}
+	})
+	t.Run("IsImporting", func(t *testing.T) {
+		t.Parallel()
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/boot-resources/?op=is_importing",
+			httpmock.NewStringResponder(http.StatusOK, "true"))
+		importing, err := resourcesClient.IsImporting()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !importing {
+			t.Fatal("IsImporting() = false, want true")
+		}
+	})
+	t.Run("StopImport", func(t *testing.T) {
+		t.Parallel()
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/boot-resources/?op=stop_import",
+			httpmock.NewStringResponder(http.StatusOK, "Import of boot resources is being stopped"))
+		if err := resourcesClient.StopImport(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// BootSource provides methods for the BootSource operations in the MaaS API.
+// This type should be instantiated via NewBootSource(). It fulfills the
+// api.BootSource interface.
+type BootSource struct {
+	c Client
+}
+
+// NewBootSource configures a new BootSource.
+func NewBootSource(client *gomaasapi.MAASObject) *BootSource {
+	c := client.GetSubObject("boot-sources")
+	return &BootSource{c: Client{&c}}
+}
+
+// client returns a Client (ie wrapped MAASOBject) for the boot source with the given ID
+func (b *BootSource) client(id int) Client {
+	return b.c.GetSubObject(strconv.Itoa(id))
+}
+
+// Delete removes a boot source, along with its selections.
+// This function returns an error if the gomaasapi returns an error.
+func (b *BootSource) Delete(id int) error {
+	return b.client(id).Delete()
+}
+
+// Get returns information about a boot source.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (b *BootSource) Get(id int) (source *entity.BootSource, err error) {
+	source = new(entity.BootSource)
+	err = b.client(id).Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, source)
+	})
+	return
+}
+
+// Put updates the URL and keyring of a boot source. gomaasapi cannot upload
+// files with a PUT, so the keyring data is sent as a string, which MaaS accepts.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (b *BootSource) Put(id int, p *params.BootSource) (source *entity.BootSource, err error) {
+	qsp := make(url.Values)
+	if p.URL != "" {
+		qsp.Set("url", p.URL)
+	}
+	if p.KeyringFilename != "" {
+		qsp.Set("keyring_filename", p.KeyringFilename)
+	}
+	if len(p.KeyringData) > 0 {
+		qsp.Set("keyring_data", string(p.KeyringData))
+	}
+	source = new(entity.BootSource)
+	err = b.client(id).Put(qsp, func(data []byte) error {
+		return json.Unmarshal(data, source)
+	})
+	return
+}
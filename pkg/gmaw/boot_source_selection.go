@@ -0,0 +1,60 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// BootSourceSelection provides methods for the BootSourceSelection operations in the MaaS API.
+// This type should be instantiated via NewBootSourceSelection(). It fulfills the
+// api.BootSourceSelection interface.
+type BootSourceSelection struct {
+	c Client
+}
+
+// NewBootSourceSelection configures a new BootSourceSelection.
+func NewBootSourceSelection(client *gomaasapi.MAASObject) *BootSourceSelection {
+	c := client.GetSubObject("boot-sources")
+	return &BootSourceSelection{c: Client{&c}}
+}
+
+// client returns a Client (ie wrapped MAASOBject) for the selection with the given boot source and ID
+func (b *BootSourceSelection) client(bootSourceID int, id int) Client {
+	return b.c.GetSubObject(strconv.Itoa(bootSourceID)).
+		GetSubObject("selections").
+		GetSubObject(strconv.Itoa(id))
+}
+
+// Delete removes a selection from <bootSourceID>.
+// This function returns an error if the gomaasapi returns an error.
+func (b *BootSourceSelection) Delete(bootSourceID int, id int) error {
+	return b.client(bootSourceID, id).Delete()
+}
+
+// Get returns information about a selection of <bootSourceID>.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (b *BootSourceSelection) Get(bootSourceID int, id int) (selection *entity.BootSourceSelection, err error) {
+	selection = new(entity.BootSourceSelection)
+	err = b.client(bootSourceID, id).Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, selection)
+	})
+	return
+}
+
+// Put updates a selection of <bootSourceID>.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (b *BootSourceSelection) Put(bootSourceID int, id int,
+	p *params.BootSourceSelection) (selection *entity.BootSourceSelection, err error) {
+	selection = new(entity.BootSourceSelection)
+	err = b.client(bootSourceID, id).Put(bootSourceSelectionQSP(p), func(data []byte) error {
+		return json.Unmarshal(data, selection)
+	})
+	return
+}
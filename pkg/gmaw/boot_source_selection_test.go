@@ -0,0 +1,95 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewBootSourceSelection(t *testing.T) {
+	NewBootSourceSelection(client)
+}
+
+func TestBootSourceSelection(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.BootSourceSelection = (*BootSourceSelection)(nil)
+
+	// Create a new boot source selection client to be used in the tests
+	selectionClient := NewBootSourceSelection(client)
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("204", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/boot-sources/21/selections/1/",
+				httpmock.NewStringResponder(http.StatusNoContent, ""))
+			if err := selectionClient.Delete(21, 1); err != nil {
+				t.Fatal(err)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/boot-sources/21/selections/2/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if err := selectionClient.Delete(21, 2); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		want := new(entity.BootSourceSelection)
+		if err := helper.TestdataFromJSON("maas/boot_source_selection.json", want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/boot-sources/21/selections/3/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := selectionClient.Get(21, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := new(entity.BootSourceSelection)
+			if err := helper.TestdataFromJSON("maas/boot_source_selection.json", want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/boot-sources/21/selections/4/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			res, err := selectionClient.Put(21, 4, &params.BootSourceSelection{Arches: want.Arches})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, res, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/boot-sources/21/selections/5/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			got, err := selectionClient.Put(21, 5, &params.BootSourceSelection{})
+			if diff := cmp.Diff((&entity.BootSourceSelection{}), got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+			if err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+}
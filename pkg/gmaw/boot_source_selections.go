@@ -0,0 +1,74 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// BootSourceSelections provides methods for the BootSourceSelections operations in the MaaS API.
+// This type should be instantiated via NewBootSourceSelections(). It fulfills the
+// api.BootSourceSelections interface.
+type BootSourceSelections struct {
+	c Client
+}
+
+// NewBootSourceSelections configures a new BootSourceSelections.
+func NewBootSourceSelections(client *gomaasapi.MAASObject) *BootSourceSelections {
+	c := client.GetSubObject("boot-sources")
+	return &BootSourceSelections{c: Client{&c}}
+}
+
+// client returns a Client (ie wrapped MAASOBject) for the selections of the given boot source
+func (b *BootSourceSelections) client(bootSourceID int) Client {
+	return b.c.GetSubObject(strconv.Itoa(bootSourceID)).GetSubObject("selections")
+}
+
+// Get returns information about all of the selections of <bootSourceID>.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (b *BootSourceSelections) Get(bootSourceID int) (selections []entity.BootSourceSelection, err error) {
+	err = b.client(bootSourceID).Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, &selections)
+	})
+	return
+}
+
+// Post creates a new selection for <bootSourceID> and returns information about the new selection.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (b *BootSourceSelections) Post(bootSourceID int,
+	p *params.BootSourceSelection) (selection *entity.BootSourceSelection, err error) {
+	selection = new(entity.BootSourceSelection)
+	err = b.client(bootSourceID).Post("", bootSourceSelectionQSP(p), func(data []byte) error {
+		return json.Unmarshal(data, selection)
+	})
+	return
+}
+
+// bootSourceSelectionQSP returns the query string parameters shared by the
+// BootSourceSelections POST and BootSourceSelection PUT operations.
+// Each of the lists is sent as a repeated parameter.
+func bootSourceSelectionQSP(p *params.BootSourceSelection) url.Values {
+	qsp := url.Values{}
+	if p.OS != "" {
+		qsp.Set("os", p.OS)
+	}
+	if p.Release != "" {
+		qsp.Set("release", p.Release)
+	}
+	for key, vals := range map[string][]string{
+		"arches":    p.Arches,
+		"subarches": p.Subarches,
+		"labels":    p.Labels,
+	} {
+		for _, val := range vals {
+			qsp.Add(key, val)
+		}
+	}
+	return qsp
+}
@@ -0,0 +1,67 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewBootSourceSelections(t *testing.T) {
+	NewBootSourceSelections(client)
+}
+
+func TestBootSourceSelections(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.BootSourceSelections = (*BootSourceSelections)(nil)
+
+	// Create a new boot source selections client to be used in the tests
+	selectionsClient := NewBootSourceSelections(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		var selections []entity.BootSourceSelection
+		if err := helper.TestdataFromJSON("maas/boot_source_selections.json", &selections); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/boot-sources/11/selections/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, selections))
+		res, err := selectionsClient.Get(11)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(selections, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(BootSourceSelections) mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("Post", func(t *testing.T) {
+		t.Parallel()
+		selection := new(entity.BootSourceSelection)
+		if err := helper.TestdataFromJSON("maas/boot_source_selection.json", selection); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/boot-sources/12/selections/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, selection))
+
+		p := &params.BootSourceSelection{
+			OS:      selection.OS,
+			Release: selection.Release,
+			Arches:  selection.Arches,
+		}
+		res, err := selectionsClient.Post(12, p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(selection, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(BootSourceSelections) mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
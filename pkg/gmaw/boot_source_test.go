@@ -0,0 +1,112 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewBootSource(t *testing.T) {
+	NewBootSource(client)
+}
+
+func TestBootSource(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.BootSource = (*BootSource)(nil)
+
+	// Create a new boot source client to be used in the tests
+	sourceClient := NewBootSource(client)
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("204", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/boot-sources/1/",
+				httpmock.NewStringResponder(http.StatusNoContent, ""))
+			if err := sourceClient.Delete(1); err != nil {
+				t.Fatal(err)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/boot-sources/2/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if err := sourceClient.Delete(2); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		want := new(entity.BootSource)
+		if err := helper.TestdataFromJSON("maas/boot_source.json", want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/boot-sources/3/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := sourceClient.Get(3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := new(entity.BootSource)
+			if err := helper.TestdataFromJSON("maas/boot_source.json", want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/boot-sources/4/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			res, err := sourceClient.Put(4, &params.BootSource{URL: want.URL})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, res, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("keyring", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/boot-sources/6/",
+				func(req *http.Request) (*http.Response, error) {
+					if err := req.ParseForm(); err != nil {
+						return nil, err
+					}
+					if diff := cmp.Diff("\x99\x01keyring", req.Form.Get("keyring_data")); diff != "" {
+						t.Errorf("keyring_data mismatch (-want +got):\n%s", diff)
+					}
+					return httpmock.NewStringResponse(http.StatusOK,
+						`{"id": 6, "resource_uri": "/MAAS/api/2.0/boot-sources/6/"}`), nil
+				})
+			if _, err := sourceClient.Put(6, &params.BootSource{KeyringData: []byte("\x99\x01keyring")}); err != nil {
+				t.Fatal(err)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/boot-sources/5/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			got, err := sourceClient.Put(5, &params.BootSource{})
+			if diff := cmp.Diff((&entity.BootSource{}), got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+			if err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+}
@@ -0,0 +1,54 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// BootSources provides methods for the BootSources operations in the MaaS API.
+// This type should be instantiated via NewBootSources(). It fulfills the
+// api.BootSources interface.
+type BootSources struct {
+	client Client
+}
+
+// NewBootSources configures a new BootSources.
+func NewBootSources(client *gomaasapi.MAASObject) *BootSources {
+	c := client.GetSubObject("boot-sources")
+	return &BootSources{client: Client{&c}}
+}
+
+// Get returns information about all of the configured boot sources.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (b *BootSources) Get() (sources []entity.BootSource, err error) {
+	err = b.client.Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, &sources)
+	})
+	return
+}
+
+// Post creates a new boot source and returns information about the new boot source.
+// The keyring data, if any, is uploaded as a file.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (b *BootSources) Post(p *params.BootSource) (source *entity.BootSource, err error) {
+	qsp := make(url.Values)
+	qsp.Set("url", p.URL)
+	if p.KeyringFilename != "" {
+		qsp.Set("keyring_filename", p.KeyringFilename)
+	}
+	var files map[string][]byte
+	if len(p.KeyringData) > 0 {
+		files = map[string][]byte{"keyring_data": p.KeyringData}
+	}
+	source = new(entity.BootSource)
+	err = b.client.PostFiles("", qsp, files, func(data []byte) error {
+		return json.Unmarshal(data, source)
+	})
+	return
+}
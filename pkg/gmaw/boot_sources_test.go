@@ -0,0 +1,63 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewBootSources(t *testing.T) {
+	NewBootSources(client)
+}
+
+func TestBootSources(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.BootSources = (*BootSources)(nil)
+
+	// Create a new boot sources client to be used in the tests
+	sourcesClient := NewBootSources(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		var sources []entity.BootSource
+		if err := helper.TestdataFromJSON("maas/boot_sources.json", &sources); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/boot-sources/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, sources))
+		res, err := sourcesClient.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(sources, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(BootSources) mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("Post", func(t *testing.T) {
+		t.Parallel()
+		source := new(entity.BootSource)
+		if err := helper.TestdataFromJSON("maas/boot_source.json", source); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/boot-sources/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, source))
+
+		p := &params.BootSource{URL: source.URL, KeyringData: []byte("keyring")}
+		res, err := sourcesClient.Post(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(source, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(BootSources) mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Device provides methods for the Device operations in the MaaS API.
+// This type should be instantiated via NewDevice(). It fulfills the
+// api.Device interface.
+type Device struct {
+	c Client
+}
+
+// NewDevice configures a new Device.
+func NewDevice(client *gomaasapi.MAASObject) *Device {
+	c := client.GetSubObject("devices")
+	return &Device{c: Client{&c}}
+}
+
+// client returns a Client (ie wrapped MAASOBject) for the device with the given system ID
+func (d *Device) client(systemID string) Client {
+	return d.c.GetSubObject(systemID)
+}
+
+// Delete removes a device.
+// This function returns an error if the gomaasapi returns an error.
+func (d *Device) Delete(systemID string) error {
+	return d.client(systemID).Delete()
+}
+
+// Get returns information about a device, including its interfaces.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (d *Device) Get(systemID string) (device *entity.Device, err error) {
+	device = new(entity.Device)
+	err = d.client(systemID).Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, device)
+	})
+	return
+}
+
+// Put updates the hostname, description, domain and parent of a device.
+// The MAC addresses of the device are managed through its interfaces.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (d *Device) Put(systemID string, p *params.Device) (device *entity.Device, err error) {
+	device = new(entity.Device)
+	err = d.client(systemID).Put(deviceQSP(p), func(data []byte) error {
+		return json.Unmarshal(data, device)
+	})
+	return
+}
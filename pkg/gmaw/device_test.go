@@ -0,0 +1,95 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewDevice(t *testing.T) {
+	NewDevice(client)
+}
+
+func TestDevice(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.Device = (*Device)(nil)
+
+	// Create a new device client to be used in the tests
+	deviceClient := NewDevice(client)
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("204", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/devices/dev001/",
+				httpmock.NewStringResponder(http.StatusNoContent, ""))
+			if err := deviceClient.Delete("dev001"); err != nil {
+				t.Fatal(err)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/devices/dev002/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if err := deviceClient.Delete("dev002"); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		want := new(entity.Device)
+		if err := helper.TestdataFromJSON("maas/device.json", want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/devices/dev003/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := deviceClient.Get("dev003")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := new(entity.Device)
+			if err := helper.TestdataFromJSON("maas/device.json", want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/devices/dev004/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			res, err := deviceClient.Put("dev004", &params.Device{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, res, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/devices/dev005/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			got, err := deviceClient.Put("dev005", &params.Device{})
+			if diff := cmp.Diff((&entity.Device{}), got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+			if err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+}
@@ -0,0 +1,65 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Devices provides methods for the Devices operations in the MaaS API.
+// This type should be instantiated via NewDevices(). It fulfills the
+// api.Devices interface.
+type Devices struct {
+	client Client
+}
+
+// NewDevices configures a new Devices.
+func NewDevices(client *gomaasapi.MAASObject) *Devices {
+	c := client.GetSubObject("devices")
+	return &Devices{client: Client{&c}}
+}
+
+// Get returns information about all of the devices.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (d *Devices) Get() (devices []entity.Device, err error) {
+	err = d.client.Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, &devices)
+	})
+	return
+}
+
+// Post creates a new device with a physical interface for each of p.MACAddresses,
+// and returns information about the new device.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (d *Devices) Post(p *params.Device) (device *entity.Device, err error) {
+	qsp := deviceQSP(p)
+	for _, mac := range p.MACAddresses {
+		qsp.Add("mac_addresses", mac)
+	}
+	device = new(entity.Device)
+	err = d.client.Post("", qsp, func(data []byte) error {
+		return json.Unmarshal(data, device)
+	})
+	return
+}
+
+// deviceQSP returns the query string parameters for the Devices POST and
+// Device PUT operations. The hostname and domain are left to MaaS when empty,
+// while an empty parent detaches the device from its parent.
+func deviceQSP(p *params.Device) url.Values {
+	qsp := make(url.Values)
+	if p.Hostname != "" {
+		qsp.Set("hostname", p.Hostname)
+	}
+	if p.Domain != "" {
+		qsp.Set("domain", p.Domain)
+	}
+	qsp.Set("description", p.Description)
+	qsp.Set("parent", p.Parent)
+	return qsp
+}
@@ -0,0 +1,63 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewDevices(t *testing.T) {
+	NewDevices(client)
+}
+
+func TestDevices(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.Devices = (*Devices)(nil)
+
+	// Create a new devices client to be used in the tests
+	devicesClient := NewDevices(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		var devices []entity.Device
+		if err := helper.TestdataFromJSON("maas/devices.json", &devices); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/devices/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, devices))
+		res, err := devicesClient.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(devices, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(Devices) mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("Post", func(t *testing.T) {
+		t.Parallel()
+		device := new(entity.Device)
+		if err := helper.TestdataFromJSON("maas/device.json", device); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/devices/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, device))
+
+		p := &params.Device{Hostname: device.Hostname, MACAddresses: []string{"00:16:3e:4a:10:01"}}
+		res, err := devicesClient.Post(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(device, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(Devices) mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
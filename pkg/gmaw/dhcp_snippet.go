@@ -0,0 +1,57 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// DHCPSnippet provides methods for the DHCP Snippet operations in the MaaS API.
+// This type should be instantiated via NewDHCPSnippet(). It fulfills the
+// api.DHCPSnippet interface.
+type DHCPSnippet struct {
+	c Client
+}
+
+// NewDHCPSnippet configures a new DHCPSnippet.
+func NewDHCPSnippet(client *gomaasapi.MAASObject) *DHCPSnippet {
+	c := client.GetSubObject("dhcp-snippets")
+	return &DHCPSnippet{c: Client{&c}}
+}
+
+// client returns a Client (ie wrapped MAASOBject) for the DHCP snippet with the given ID
+func (s *DHCPSnippet) client(id int) Client {
+	return s.c.GetSubObject(strconv.Itoa(id))
+}
+
+// Delete removes a DHCP snippet.
+// This function returns an error if the gomaasapi returns an error.
+func (s *DHCPSnippet) Delete(id int) error {
+	return s.client(id).Delete()
+}
+
+// Get returns information about a DHCP snippet.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (s *DHCPSnippet) Get(id int) (snippet *entity.DHCPSnippet, err error) {
+	snippet = new(entity.DHCPSnippet)
+	err = s.client(id).Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, snippet)
+	})
+	return
+}
+
+// Put updates a DHCP snippet. Changing the value keeps the previous values in its history.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (s *DHCPSnippet) Put(id int, p *params.DHCPSnippet) (snippet *entity.DHCPSnippet, err error) {
+	snippet = new(entity.DHCPSnippet)
+	err = s.client(id).Put(dhcpSnippetQSP(p), func(data []byte) error {
+		return json.Unmarshal(data, snippet)
+	})
+	return
+}
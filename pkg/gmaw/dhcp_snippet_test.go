@@ -0,0 +1,95 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewDHCPSnippet(t *testing.T) {
+	NewDHCPSnippet(client)
+}
+
+func TestDHCPSnippet(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.DHCPSnippet = (*DHCPSnippet)(nil)
+
+	// Create a new DHCP snippet client to be used in the tests
+	snippetClient := NewDHCPSnippet(client)
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("204", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/dhcp-snippets/1/",
+				httpmock.NewStringResponder(http.StatusNoContent, ""))
+			if err := snippetClient.Delete(1); err != nil {
+				t.Fatal(err)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/dhcp-snippets/2/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if err := snippetClient.Delete(2); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		want := new(entity.DHCPSnippet)
+		if err := helper.TestdataFromJSON("maas/dhcp_snippet.json", want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/dhcp-snippets/3/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := snippetClient.Get(3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := new(entity.DHCPSnippet)
+			if err := helper.TestdataFromJSON("maas/dhcp_snippet.json", want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/dhcp-snippets/4/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			res, err := snippetClient.Put(4, &params.DHCPSnippet{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, res, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/dhcp-snippets/5/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			got, err := snippetClient.Put(5, &params.DHCPSnippet{})
+			if diff := cmp.Diff((&entity.DHCPSnippet{}), got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+			if err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+}
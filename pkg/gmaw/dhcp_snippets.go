@@ -0,0 +1,64 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// DHCPSnippets provides methods for the DHCP Snippets operations in the MaaS API.
+// This type should be instantiated via NewDHCPSnippets(). It fulfills the
+// api.DHCPSnippets interface.
+type DHCPSnippets struct {
+	client Client
+}
+
+// NewDHCPSnippets configures a new DHCPSnippets.
+func NewDHCPSnippets(client *gomaasapi.MAASObject) *DHCPSnippets {
+	c := client.GetSubObject("dhcp-snippets")
+	return &DHCPSnippets{client: Client{&c}}
+}
+
+// Get returns information about all of the DHCP snippets.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (s *DHCPSnippets) Get() (snippets []entity.DHCPSnippet, err error) {
+	err = s.client.Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, &snippets)
+	})
+	return
+}
+
+// Post creates a new DHCP snippet and returns information about the new snippet.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (s *DHCPSnippets) Post(p *params.DHCPSnippet) (snippet *entity.DHCPSnippet, err error) {
+	snippet = new(entity.DHCPSnippet)
+	err = s.client.Post("", dhcpSnippetQSP(p), func(data []byte) error {
+		return json.Unmarshal(data, snippet)
+	})
+	return
+}
+
+// dhcpSnippetQSP returns the query string parameters for the DHCPSnippets POST and
+// DHCPSnippet PUT operations. The node and subnet are always sent, so that an update
+// moving the snippet to another scope clears the previous one.
+func dhcpSnippetQSP(p *params.DHCPSnippet) url.Values {
+	qsp := make(url.Values)
+	qsp.Set("name", p.Name)
+	qsp.Set("value", p.Value)
+	qsp.Set("description", p.Description)
+	qsp.Set("enabled", strconv.FormatBool(p.Enabled))
+	qsp.Set("global_snippet", strconv.FormatBool(p.GlobalSnippet))
+	qsp.Set("node", p.Node)
+	if p.Subnet > 0 {
+		qsp.Set("subnet", strconv.Itoa(p.Subnet))
+	} else {
+		qsp.Set("subnet", "")
+	}
+	return qsp
+}
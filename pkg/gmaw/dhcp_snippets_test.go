@@ -0,0 +1,63 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewDHCPSnippets(t *testing.T) {
+	NewDHCPSnippets(client)
+}
+
+func TestDHCPSnippets(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.DHCPSnippets = (*DHCPSnippets)(nil)
+
+	// Create a new DHCP snippets client to be used in the tests
+	snippetsClient := NewDHCPSnippets(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		var snippets []entity.DHCPSnippet
+		if err := helper.TestdataFromJSON("maas/dhcp_snippets.json", &snippets); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/dhcp-snippets/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, snippets))
+		res, err := snippetsClient.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(snippets, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(DHCPSnippets) mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("Post", func(t *testing.T) {
+		t.Parallel()
+		snippet := new(entity.DHCPSnippet)
+		if err := helper.TestdataFromJSON("maas/dhcp_snippet.json", snippet); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/dhcp-snippets/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, snippet))
+
+		p := &params.DHCPSnippet{Name: snippet.Name, Value: snippet.Value, Subnet: snippet.Subnet.ID, Enabled: true}
+		res, err := snippetsClient.Post(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(snippet, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(DHCPSnippets) mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
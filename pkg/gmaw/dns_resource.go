@@ -0,0 +1,61 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// DNSResource provides methods for the DNSResource operations in the MaaS API.
+// This type should be instantiated via NewDNSResource(). It fulfills the
+// api.DNSResource interface.
+type DNSResource struct {
+	c Client
+}
+
+// NewDNSResource configures a new DNSResource.
+func NewDNSResource(client *gomaasapi.MAASObject) *DNSResource {
+	c := client.GetSubObject("dnsresources")
+	return &DNSResource{c: Client{&c}}
+}
+
+// client returns a Client (ie wrapped MAASOBject) for the DNS resource with the given ID
+func (d *DNSResource) client(id int) Client {
+	return d.c.GetSubObject(strconv.Itoa(id))
+}
+
+// Delete removes a DNS resource.
+// This function returns an error if the gomaasapi returns an error.
+func (d *DNSResource) Delete(id int) error {
+	return d.client(id).Delete()
+}
+
+// Get returns information about a DNS resource.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (d *DNSResource) Get(id int) (res *entity.DNSResource, err error) {
+	res = new(entity.DNSResource)
+	err = d.client(id).Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, res)
+	})
+	return
+}
+
+// Put updates the configuration of a DNS resource. The IP addresses are always
+// sent, so that removing the last address clears the addresses of the DNS resource.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (d *DNSResource) Put(id int, p *params.DNSResource) (res *entity.DNSResource, err error) {
+	res = new(entity.DNSResource)
+	qsp := dnsResourceQSP(p)
+	qsp.Set("ip_addresses", strings.Join(p.IPAddresses, " "))
+	err = d.client(id).Put(qsp, func(data []byte) error {
+		return json.Unmarshal(data, res)
+	})
+	return
+}
@@ -0,0 +1,57 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// DNSResourceRecord provides methods for the DNSResourceRecord operations in the MaaS API.
+// This type should be instantiated via NewDNSResourceRecord(). It fulfills the
+// api.DNSResourceRecord interface.
+type DNSResourceRecord struct {
+	c Client
+}
+
+// NewDNSResourceRecord configures a new DNSResourceRecord.
+func NewDNSResourceRecord(client *gomaasapi.MAASObject) *DNSResourceRecord {
+	c := client.GetSubObject("dnsresourcerecords")
+	return &DNSResourceRecord{c: Client{&c}}
+}
+
+// client returns a Client (ie wrapped MAASOBject) for the DNS resource record with the given ID
+func (d *DNSResourceRecord) client(id int) Client {
+	return d.c.GetSubObject(strconv.Itoa(id))
+}
+
+// Delete removes a DNS resource record.
+// This function returns an error if the gomaasapi returns an error.
+func (d *DNSResourceRecord) Delete(id int) error {
+	return d.client(id).Delete()
+}
+
+// Get returns information about a DNS resource record.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (d *DNSResourceRecord) Get(id int) (record *entity.DNSResourceRecord, err error) {
+	record = new(entity.DNSResourceRecord)
+	err = d.client(id).Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, record)
+	})
+	return
+}
+
+// Put updates the configuration of a DNS resource record.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (d *DNSResourceRecord) Put(id int, p *params.DNSResourceRecord) (record *entity.DNSResourceRecord, err error) {
+	record = new(entity.DNSResourceRecord)
+	err = d.client(id).Put(dnsResourceRecordQSP(p), func(data []byte) error {
+		return json.Unmarshal(data, record)
+	})
+	return
+}
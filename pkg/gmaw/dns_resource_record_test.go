@@ -0,0 +1,95 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewDNSResourceRecord(t *testing.T) {
+	NewDNSResourceRecord(client)
+}
+
+func TestDNSResourceRecord(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.DNSResourceRecord = (*DNSResourceRecord)(nil)
+
+	// Create a new DNS resource record client to be used in the tests
+	recordClient := NewDNSResourceRecord(client)
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("204", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/dnsresourcerecords/1/",
+				httpmock.NewStringResponder(http.StatusNoContent, ""))
+			if err := recordClient.Delete(1); err != nil {
+				t.Fatal(err)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/dnsresourcerecords/2/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if err := recordClient.Delete(2); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		want := new(entity.DNSResourceRecord)
+		if err := helper.TestdataFromJSON("maas/dns_resource_record.json", want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/dnsresourcerecords/3/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := recordClient.Get(3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := new(entity.DNSResourceRecord)
+			if err := helper.TestdataFromJSON("maas/dns_resource_record.json", want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/dnsresourcerecords/4/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			res, err := recordClient.Put(4, &params.DNSResourceRecord{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, res, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/dnsresourcerecords/5/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			got, err := recordClient.Put(5, &params.DNSResourceRecord{})
+			if diff := cmp.Diff((&entity.DNSResourceRecord{}), got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+			if err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+}
@@ -0,0 +1,67 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// DNSResourceRecords provides methods for the DNSResourceRecords operations in the MaaS API.
+// This type should be instantiated via NewDNSResourceRecords(). It fulfills the
+// api.DNSResourceRecords interface.
+type DNSResourceRecords struct {
+	client Client
+}
+
+// NewDNSResourceRecords configures a new DNSResourceRecords.
+func NewDNSResourceRecords(client *gomaasapi.MAASObject) *DNSResourceRecords {
+	c := client.GetSubObject("dnsresourcerecords")
+	return &DNSResourceRecords{client: Client{&c}}
+}
+
+// Get returns information about all of the DNS resource records.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (d *DNSResourceRecords) Get() (records []entity.DNSResourceRecord, err error) {
+	err = d.client.Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, &records)
+	})
+	return
+}
+
+// Post creates a new DNS resource record and returns information about the new record.
+// Address records (A and AAAA) are managed through the DNSResources endpoint instead.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (d *DNSResourceRecords) Post(p *params.DNSResourceRecord) (record *entity.DNSResourceRecord, err error) {
+	record = new(entity.DNSResourceRecord)
+	err = d.client.Post("", dnsResourceRecordQSP(p), func(data []byte) error {
+		return json.Unmarshal(data, record)
+	})
+	return
+}
+
+// dnsResourceRecordQSP returns the query string parameters for the DNSResourceRecords
+// POST and DNSResourceRecord PUT operations. Only the parameters that are set are included.
+func dnsResourceRecordQSP(p *params.DNSResourceRecord) url.Values {
+	qsp := make(url.Values)
+	for key, val := range map[string]string{
+		"fqdn":   p.FQDN,
+		"name":   p.Name,
+		"domain": p.Domain,
+		"rrtype": p.RRType,
+		"rrdata": p.RRData,
+	} {
+		if val != "" {
+			qsp.Set(key, val)
+		}
+	}
+	if p.TTL > 0 {
+		qsp.Set("ttl", strconv.Itoa(p.TTL))
+	}
+	return qsp
+}
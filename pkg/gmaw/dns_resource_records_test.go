@@ -0,0 +1,63 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewDNSResourceRecords(t *testing.T) {
+	NewDNSResourceRecords(client)
+}
+
+func TestDNSResourceRecords(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.DNSResourceRecords = (*DNSResourceRecords)(nil)
+
+	// Create a new DNS resource records client to be used in the tests
+	recordsClient := NewDNSResourceRecords(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		var records []entity.DNSResourceRecord
+		if err := helper.TestdataFromJSON("maas/dns_resource_records.json", &records); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/dnsresourcerecords/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, records))
+		res, err := recordsClient.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(records, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(DNSResourceRecords) mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("Post", func(t *testing.T) {
+		t.Parallel()
+		record := new(entity.DNSResourceRecord)
+		if err := helper.TestdataFromJSON("maas/dns_resource_record.json", record); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/dnsresourcerecords/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, record))
+
+		p := &params.DNSResourceRecord{FQDN: record.FQDN, RRType: record.RRType, RRData: record.RRData}
+		res, err := recordsClient.Post(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(record, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(DNSResourceRecords) mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
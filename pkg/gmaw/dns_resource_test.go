@@ -0,0 +1,95 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewDNSResource(t *testing.T) {
+	NewDNSResource(client)
+}
+
+func TestDNSResource(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.DNSResource = (*DNSResource)(nil)
+
+	// Create a new DNS resource client to be used in the tests
+	resourceClient := NewDNSResource(client)
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("204", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/dnsresources/1/",
+				httpmock.NewStringResponder(http.StatusNoContent, ""))
+			if err := resourceClient.Delete(1); err != nil {
+				t.Fatal(err)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/dnsresources/2/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if err := resourceClient.Delete(2); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		want := new(entity.DNSResource)
+		if err := helper.TestdataFromJSON("maas/dns_resource.json", want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/dnsresources/3/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := resourceClient.Get(3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := new(entity.DNSResource)
+			if err := helper.TestdataFromJSON("maas/dns_resource.json", want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/dnsresources/4/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			res, err := resourceClient.Put(4, &params.DNSResource{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, res, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/dnsresources/5/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			got, err := resourceClient.Put(5, &params.DNSResource{})
+			if diff := cmp.Diff((&entity.DNSResource{}), got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+			if err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+}
@@ -0,0 +1,67 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// DNSResources provides methods for the DNSResources operations in the MaaS API.
+// This type should be instantiated via NewDNSResources(). It fulfills the
+// api.DNSResources interface.
+type DNSResources struct {
+	client Client
+}
+
+// NewDNSResources configures a new DNSResources.
+func NewDNSResources(client *gomaasapi.MAASObject) *DNSResources {
+	c := client.GetSubObject("dnsresources")
+	return &DNSResources{client: Client{&c}}
+}
+
+// Get returns information about all of the DNS resources that were not
+// generated by MaaS for its nodes.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (d *DNSResources) Get() (resources []entity.DNSResource, err error) {
+	err = d.client.Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, &resources)
+	})
+	return
+}
+
+// Post creates a new DNS resource and returns information about the new DNS resource.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (d *DNSResources) Post(p *params.DNSResource) (res *entity.DNSResource, err error) {
+	res = new(entity.DNSResource)
+	err = d.client.Post("", dnsResourceQSP(p), func(data []byte) error {
+		return json.Unmarshal(data, res)
+	})
+	return
+}
+
+// dnsResourceQSP returns the query string parameters for the DNSResources POST
+// and DNSResource PUT operations. Only the parameters that are set are included.
+func dnsResourceQSP(p *params.DNSResource) url.Values {
+	qsp := make(url.Values)
+	for key, val := range map[string]string{
+		"fqdn":         p.FQDN,
+		"name":         p.Name,
+		"domain":       p.Domain,
+		"ip_addresses": strings.Join(p.IPAddresses, " "),
+	} {
+		if val != "" {
+			qsp.Set(key, val)
+		}
+	}
+	if p.AddressTTL > 0 {
+		qsp.Set("address_ttl", strconv.Itoa(p.AddressTTL))
+	}
+	return qsp
+}
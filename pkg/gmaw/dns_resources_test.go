@@ -0,0 +1,63 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewDNSResources(t *testing.T) {
+	NewDNSResources(client)
+}
+
+func TestDNSResources(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.DNSResources = (*DNSResources)(nil)
+
+	// Create a new DNS resources client to be used in the tests
+	resourcesClient := NewDNSResources(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		var resources []entity.DNSResource
+		if err := helper.TestdataFromJSON("maas/dns_resources.json", &resources); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/dnsresources/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, resources))
+		res, err := resourcesClient.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(resources, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(DNSResources) mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("Post", func(t *testing.T) {
+		t.Parallel()
+		resource := new(entity.DNSResource)
+		if err := helper.TestdataFromJSON("maas/dns_resource.json", resource); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/dnsresources/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, resource))
+
+		p := &params.DNSResource{FQDN: resource.FQDN, AddressTTL: resource.AddressTTL, IPAddresses: []string{"10.0.0.25"}}
+		res, err := resourcesClient.Post(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(resource, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(DNSResources) mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Domain provides methods for the Domain operations in the MaaS API.
+// This type should be instantiated via NewDomain(). It fulfills the
+// api.Domain interface.
+type Domain struct {
+	c Client
+}
+
+// NewDomain configures a new Domain.
+func NewDomain(client *gomaasapi.MAASObject) *Domain {
+	c := client.GetSubObject("domains")
+	return &Domain{c: Client{&c}}
+}
+
+// client returns a Client (ie wrapped MAASOBject) for the domain with the given ID
+func (d *Domain) client(id int) Client {
+	return d.c.GetSubObject(strconv.Itoa(id))
+}
+
+// Delete removes a domain. The default domain cannot be removed.
+// This function returns an error if the gomaasapi returns an error.
+func (d *Domain) Delete(id int) error {
+	return d.client(id).Delete()
+}
+
+// Get returns information about a domain.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (d *Domain) Get(id int) (domain *entity.Domain, err error) {
+	domain = new(entity.Domain)
+	err = d.client(id).Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, domain)
+	})
+	return
+}
+
+// Put updates the configuration of a domain.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (d *Domain) Put(id int, p *params.Domain) (domain *entity.Domain, err error) {
+	domain = new(entity.Domain)
+	err = d.client(id).Put(domainQSP(p), func(data []byte) error {
+		return json.Unmarshal(data, domain)
+	})
+	return
+}
+
+// SetDefault makes the domain the default domain for new machines.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (d *Domain) SetDefault(id int) (domain *entity.Domain, err error) {
+	domain = new(entity.Domain)
+	err = d.client(id).Post("set_default", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, domain)
+	})
+	return
+}
@@ -0,0 +1,122 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewDomain(t *testing.T) {
+	NewDomain(client)
+}
+
+func TestDomain(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.Domain = (*Domain)(nil)
+
+	// Create a new domain client to be used in the tests
+	domainClient := NewDomain(client)
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("204", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/domains/1/",
+				httpmock.NewStringResponder(http.StatusNoContent, ""))
+			if err := domainClient.Delete(1); err != nil {
+				t.Fatal(err)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/domains/2/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if err := domainClient.Delete(2); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		want := new(entity.Domain)
+		if err := helper.TestdataFromJSON("maas/domain.json", want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/domains/3/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := domainClient.Get(3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := new(entity.Domain)
+			if err := helper.TestdataFromJSON("maas/domain.json", want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/domains/4/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			res, err := domainClient.Put(4, &params.Domain{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, res, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/domains/5/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			got, err := domainClient.Put(5, &params.Domain{})
+			if diff := cmp.Diff((&entity.Domain{}), got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+			if err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("SetDefault", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := new(entity.Domain)
+			if err := helper.TestdataFromJSON("maas/domain.json", want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("POST", "/MAAS/api/2.0/domains/6/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			res, err := domainClient.SetDefault(6)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, res, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("POST", "/MAAS/api/2.0/domains/7/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if _, err := domainClient.SetDefault(7); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+}
@@ -0,0 +1,65 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Domains provides methods for the Domains operations in the MaaS API.
+// This type should be instantiated via NewDomains(). It fulfills the
+// api.Domains interface.
+type Domains struct {
+	client Client
+}
+
+// NewDomains configures a new Domains.
+func NewDomains(client *gomaasapi.MAASObject) *Domains {
+	c := client.GetSubObject("domains")
+	return &Domains{client: Client{&c}}
+}
+
+// Get returns information about all of the configured domains.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (d *Domains) Get() (domains []entity.Domain, err error) {
+	err = d.client.Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, &domains)
+	})
+	return
+}
+
+// Post creates a new domain and returns information about the new domain.
+// The domain uses the default TTL if p.TTL is zero.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (d *Domains) Post(p *params.Domain) (domain *entity.Domain, err error) {
+	qsp := domainQSP(p)
+	if p.TTL == 0 {
+		qsp.Del("ttl")
+	}
+	domain = new(entity.Domain)
+	err = d.client.Post("", qsp, func(data []byte) error {
+		return json.Unmarshal(data, domain)
+	})
+	return
+}
+
+// domainQSP returns the query string parameters for the Domains POST and
+// Domain PUT operations. A zero TTL is sent empty, which resets the domain
+// to the default TTL.
+func domainQSP(p *params.Domain) url.Values {
+	qsp := make(url.Values)
+	qsp.Set("name", p.Name)
+	qsp.Set("authoritative", strconv.FormatBool(p.Authoritative))
+	if p.TTL > 0 {
+		qsp.Set("ttl", strconv.Itoa(p.TTL))
+	} else {
+		qsp.Set("ttl", "")
+	}
+	return qsp
+}
@@ -0,0 +1,63 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewDomains(t *testing.T) {
+	NewDomains(client)
+}
+
+func TestDomains(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.Domains = (*Domains)(nil)
+
+	// Create a new domains client to be used in the tests
+	domainsClient := NewDomains(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		var domains []entity.Domain
+		if err := helper.TestdataFromJSON("maas/domains.json", &domains); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/domains/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, domains))
+		res, err := domainsClient.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(domains, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(Domains) mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("Post", func(t *testing.T) {
+		t.Parallel()
+		domain := new(entity.Domain)
+		if err := helper.TestdataFromJSON("maas/domain.json", domain); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/domains/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, domain))
+
+		p := &params.Domain{Name: domain.Name, TTL: domain.TTL, Authoritative: domain.Authoritative}
+		res, err := domainsClient.Post(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(domain, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(Domains) mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -38,7 +38,15 @@ res, err := gmaw.NewMachineManager(myMAAS).Deploy('your_sid', maas.MachineDeploy
 package gmaw
 
 import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
 	"net/url"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/juju/gomaasapi"
 )
@@ -48,7 +56,23 @@ import (
 // and calls NewMAAS() on the returned value to create the resulting MAASObject.
 // A non-nil error will be from GetAuthenticatedClient, and the returned client
 // can be used with the other types in this package.
-func GetClient(apiURL, apiKey, apiVersion string) (*gomaasapi.MAASObject, error) {
+//
+// When failover URLs are given, apiURL and then each failover URL are health
+// checked in order, and the client uses the first region controller whose API
+// answers. An error is returned if none of them answer. Afterwards, the requests
+// of the client are sent to the next region controller when the current one stops
+// answering, see failoverTransport. The clients for the same URLs fail over together.
+func GetClient(apiURL, apiKey, apiVersion string, failoverURLs ...string) (*gomaasapi.MAASObject, error) {
+	if len(failoverURLs) > 0 {
+		apiURLs := append([]string{apiURL}, failoverURLs...)
+		current, err := healthyAPIURL(apiURLs, apiVersion)
+		if err != nil {
+			return nil, err
+		}
+		if apiURL, err = registerFailoverTransport(apiURLs, current); err != nil {
+			return nil, err
+		}
+	}
 	authClient, err := gomaasapi.NewAuthenticatedClient(
 		gomaasapi.AddAPIVersionToURL(apiURL, apiVersion), apiKey)
 	if err != nil {
@@ -57,6 +81,210 @@ func GetClient(apiURL, apiKey, apiVersion string) (*gomaasapi.MAASObject, error)
 	return gomaasapi.NewMAAS(*authClient), nil
 }
 
+// healthCheckTimeout is how long a region controller has to answer the health check
+const healthCheckTimeout = 10 * time.Second
+
+// healthyAPIURL returns the index of the first of <apiURLs> whose API answers the version
+// endpoint, which does not require authentication.
+func healthyAPIURL(apiURLs []string, apiVersion string) (int, error) {
+	client := http.Client{Timeout: healthCheckTimeout}
+	errs := make([]string, 0, len(apiURLs))
+	for idx, apiURL := range apiURLs {
+		res, err := client.Get(gomaasapi.AddAPIVersionToURL(apiURL, apiVersion) + "version/")
+		if err == nil {
+			res.Body.Close() // nolint: errcheck
+			if res.StatusCode == http.StatusOK {
+				return idx, nil
+			}
+			err = fmt.Errorf("unexpected status %s", res.Status)
+		}
+		errs = append(errs, fmt.Sprintf("%s: %s", apiURL, err))
+	}
+	return 0, fmt.Errorf("none of the MAAS API URLs answer: %s", strings.Join(errs, "; "))
+}
+
+// failoverScheme is the URL scheme of the clients that fail over, see failoverTransport
+const failoverScheme = "maas-failover"
+
+// failoverResponseTimeout is how long a region controller has to start answering a request
+// before it times out
+const failoverResponseTimeout = 5 * time.Minute
+
+// failoverTransports holds a failoverTransport for each set of region controllers, under
+// the host the API URLs of its clients are given. A client for the same set of region
+// controllers reuses its failoverTransport, so that they are not registered once per client.
+var failoverTransports = struct {
+	sync.Once
+	sync.Mutex
+	err        error
+	hosts      map[string]string
+	transports map[string]*failoverTransport
+}{hosts: map[string]string{}, transports: map[string]*failoverTransport{}}
+
+// failoverTransport sends the requests of its clients to their current region controller,
+// and moves on to the next region controller when the current one cannot be reached or times
+// out, or, for requests that are safe to repeat, when its proxy reports it as unavailable.
+// Only the scheme and host of a request are rewritten, so the region controllers are expected
+// to serve the API under the same path. It sends the requests with its own http.Transport.
+//
+// gomaasapi sends its requests with a zero http.Client and offers no way to use another
+// one, so the clients are given an API URL with the failoverScheme, whose host names their
+// failoverTransport. The scheme is registered once with the http.DefaultTransport, which
+// hands its requests to failoverRoundTripper. Requests with other schemes are not affected.
+type failoverTransport struct {
+	next    http.RoundTripper
+	regions []*url.URL
+
+	mu      sync.Mutex
+	current int
+}
+
+// failoverRoundTripper hands the requests with the failoverScheme to the failoverTransport
+// named by their host.
+type failoverRoundTripper struct{}
+
+// RoundTrip implements http.RoundTripper.
+func (failoverRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	failoverTransports.Lock()
+	t, ok := failoverTransports.transports[req.URL.Host]
+	failoverTransports.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown MAAS failover host %s", req.URL.Host)
+	}
+	return t.RoundTrip(req)
+}
+
+// registerFailoverTransport returns the API URL of a client for <apiURLs> that starts with the
+// region controller at index <current>, registering a failoverTransport for them if needed.
+func registerFailoverTransport(apiURLs []string, current int) (string, error) {
+	failoverTransports.Do(func() {
+		defaultTransport, ok := http.DefaultTransport.(*http.Transport)
+		if !ok {
+			failoverTransports.err = fmt.Errorf(
+				"failover needs http.DefaultTransport to be an *http.Transport (got %T)", http.DefaultTransport)
+			return
+		}
+		defaultTransport.RegisterProtocol(failoverScheme, failoverRoundTripper{})
+	})
+	if failoverTransports.err != nil {
+		return "", failoverTransports.err
+	}
+	regions := make([]*url.URL, 0, len(apiURLs))
+	for _, apiURL := range apiURLs {
+		u, err := url.Parse(apiURL)
+		if err != nil {
+			return "", err
+		}
+		regions = append(regions, u)
+	}
+
+	failoverTransports.Lock()
+	defer failoverTransports.Unlock()
+	key := strings.Join(apiURLs, " ")
+	host, ok := failoverTransports.hosts[key]
+	if !ok {
+		host = fmt.Sprintf("regions-%d", len(failoverTransports.hosts)+1)
+		failoverTransports.hosts[key] = host
+		failoverTransports.transports[host] = &failoverTransport{
+			next: &http.Transport{
+				Proxy: http.ProxyFromEnvironment,
+				DialContext: (&net.Dialer{
+					Timeout:   30 * time.Second, // nolint: gomnd
+					KeepAlive: 30 * time.Second, // nolint: gomnd
+				}).DialContext,
+				TLSHandshakeTimeout:   10 * time.Second, // nolint: gomnd
+				ResponseHeaderTimeout: failoverResponseTimeout,
+				ExpectContinueTimeout: 1 * time.Second,
+			},
+			regions: regions,
+		}
+	}
+	t := failoverTransports.transports[host]
+	t.mu.Lock()
+	t.current = current
+	t.mu.Unlock()
+
+	u := *regions[current]
+	u.Scheme, u.Host = failoverScheme, host
+	return u.String(), nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// The body is replayed for each region controller that is tried
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close() // nolint: errcheck
+	}
+
+	var res *http.Response
+	var err error
+	for range t.regions {
+		idx, region := t.region()
+		r, u := *req, *req.URL
+		u.Scheme, u.Host = region.Scheme, region.Host
+		r.URL, r.Host = &u, region.Host
+		if body != nil {
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+		res, err = t.next.RoundTrip(&r)
+		if !isUnavailable(&r, res, err) {
+			return res, err
+		}
+		t.failed(idx)
+		if res != nil {
+			res.Body.Close() // nolint: errcheck
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("none of the MAAS API URLs answer: %s", res.Status)
+}
+
+// region returns the region controller requests are currently sent to, and its index.
+func (t *failoverTransport) region() (int, *url.URL) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.current, t.regions[t.current]
+}
+
+// failed moves on to the next region controller, unless a concurrent request
+// already moved on from the region controller at index <idx>.
+func (t *failoverTransport) failed(idx int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.current == idx {
+		t.current = (idx + 1) % len(t.regions)
+	}
+}
+
+// isUnavailable returns true if <req> failed because the region controller is unavailable,
+// and it is safe to send it to another one. A request that could not be sent is always safe
+// to repeat. A timeout or a proxy error, however, may happen after the region controller did
+// the work, so only GET requests are repeated then, and not operations such as allocate or deploy.
+func isUnavailable(req *http.Request, res *http.Response, err error) bool {
+	if err != nil {
+		if opErr, ok := err.(*net.OpError); ok && opErr.Op == "dial" {
+			return true
+		}
+		netErr, ok := err.(net.Error)
+		return ok && netErr.Timeout() && req.Method == http.MethodGet
+	}
+	if req.Method != http.MethodGet {
+		return false
+	}
+	switch res.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
 type Client struct {
 	*gomaasapi.MAASObject
 }
@@ -90,6 +318,18 @@ func (c Client) Post(op string, params url.Values, f func([]byte) error) error {
 	return f(data)
 }
 
+func (c Client) PostFiles(op string, params url.Values, files map[string][]byte, f func([]byte) error) error {
+	res, err := c.CallPostFiles(op, params, files)
+	if err != nil {
+		return err
+	}
+	data, err := res.GetBytes()
+	if err != nil {
+		return err
+	}
+	return f(data)
+}
+
 func (c Client) Put(params url.Values, f func([]byte) error) error {
 	res, err := c.Update(params)
 	if err != nil {
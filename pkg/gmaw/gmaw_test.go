@@ -3,6 +3,9 @@ package gmaw_test
 import (
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
 	"testing"
 
 	"github.com/jarcoal/httpmock"
@@ -85,3 +88,100 @@ func TestGetClient(t *testing.T) {
 		t.Fatal(diff)
 	}
 }
+
+func TestGetClient_Failover(t *testing.T) {
+	// The failover transport sends real requests, so httpmock is not used here
+	httpmock.Deactivate()
+	defer httpmock.Activate()
+	version := "2.0"
+
+	// region1 does not answer and region2 is unhealthy, so region3 is used
+	region1 := httptest.NewServer(http.NotFoundHandler())
+	region1.Close()
+	var region2Up int32
+	region2 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&region2Up) == 0 {
+			http.Error(w, "Bad Gateway", http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, `[{"id": 1, "name": "default"}]`)
+	}))
+	defer region2.Close()
+	var region3Posts int32
+	region3 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			atomic.AddInt32(&region3Posts, 1)
+			http.Error(w, "Bad Gateway", http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, `{"version": "2.7.0"}`)
+	}))
+	var region4Gets int32
+	region4 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&region4Gets, 1)
+		fmt.Fprint(w, `[{"id": 2, "name": "other"}]`)
+	}))
+	defer region4.Close()
+
+	res, err := GetClient(region1.URL+"/MAAS", "secr3t:key:s3cret", version,
+		region2.URL+"/MAAS", region3.URL+"/MAAS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("/MAAS/api/2.0/", res.URL().Path); diff != "" {
+		t.Fatal(diff)
+	}
+
+	// A client for the same region controllers shares the failover transport
+	same, err := GetClient(region1.URL+"/MAAS", "secr3t:key:s3cret", version,
+		region2.URL+"/MAAS", region3.URL+"/MAAS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(res.URL().String(), same.URL().String()); diff != "" {
+		t.Fatal(diff)
+	}
+
+	// Another client fails over on its own
+	other, err := GetClient(region4.URL+"/MAAS", "secr3t:key:s3cret", version, region1.URL+"/MAAS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.URL().Host == other.URL().Host {
+		t.Fatalf("Expected clients for other region controllers to use another host, got %s", res.URL().Host)
+	}
+
+	// A POST that gets a proxy error is not sent to another region
+	if _, err := res.GetSubObject("machines/").CallPost("allocate", url.Values{}); err == nil {
+		t.Fatal("Expected an error from the POST")
+	}
+	if n := atomic.LoadInt32(&region3Posts); n != 1 {
+		t.Fatalf("Expected the POST to be sent once, got %d", n)
+	}
+
+	// region3 goes away, so the requests are sent to the next region that answers
+	region3.Close()
+	atomic.StoreInt32(&region2Up, 1)
+	zones, err := NewZones(res).Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(zones) != 1 || zones[0].Name != "default" {
+		t.Fatalf("Expected the zone from region2, got %+v", zones)
+	}
+	if n := atomic.LoadInt32(&region4Gets); n != 1 {
+		t.Fatalf("Expected one request to region4, got %d", n)
+	}
+	if zones, err = NewZones(other).Get(); err != nil {
+		t.Fatal(err)
+	}
+	if len(zones) != 1 || zones[0].Name != "other" {
+		t.Fatalf("Expected the zone from region4, got %+v", zones)
+	}
+
+	// None of the regions answer
+	if _, err := GetClient(region1.URL+"/MAAS", "secr3t:key:s3cret", version,
+		region3.URL+"/MAAS"); err == nil {
+		t.Fatal("Expected an error when none of the regions answer")
+	}
+}
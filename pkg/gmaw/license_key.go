@@ -0,0 +1,58 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// LicenseKey provides methods for the License Key operations in the MaaS API.
+// This type should be instantiated via NewLicenseKey(). It fulfills the
+// api.LicenseKey interface.
+type LicenseKey struct {
+	c Client
+}
+
+// NewLicenseKey configures a new LicenseKey.
+// Unlike the LicenseKeys endpoint, the path of this endpoint is singular.
+func NewLicenseKey(client *gomaasapi.MAASObject) *LicenseKey {
+	c := client.GetSubObject("license-key")
+	return &LicenseKey{c: Client{&c}}
+}
+
+// client returns a Client (ie wrapped MAASOBject) for the license key of the given OS release
+func (l *LicenseKey) client(osystem, distroSeries string) Client {
+	return l.c.GetSubObject(osystem).GetSubObject(distroSeries)
+}
+
+// Delete removes the license key of an OS release.
+// This function returns an error if the gomaasapi returns an error.
+func (l *LicenseKey) Delete(osystem, distroSeries string) error {
+	return l.client(osystem, distroSeries).Delete()
+}
+
+// Get returns the license key of an OS release.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (l *LicenseKey) Get(osystem, distroSeries string) (key *entity.LicenseKey, err error) {
+	key = new(entity.LicenseKey)
+	err = l.client(osystem, distroSeries).Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, key)
+	})
+	return
+}
+
+// Put updates the license key of an OS release.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (l *LicenseKey) Put(osystem, distroSeries string, p *params.LicenseKey) (key *entity.LicenseKey, err error) {
+	key = new(entity.LicenseKey)
+	qsp := url.Values{"license_key": {p.LicenseKey}}
+	err = l.client(osystem, distroSeries).Put(qsp, func(data []byte) error {
+		return json.Unmarshal(data, key)
+	})
+	return
+}
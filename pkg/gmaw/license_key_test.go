@@ -0,0 +1,95 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewLicenseKey(t *testing.T) {
+	NewLicenseKey(client)
+}
+
+func TestLicenseKey(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.LicenseKey = (*LicenseKey)(nil)
+
+	// Create a new license key client to be used in the tests
+	keyClient := NewLicenseKey(client)
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("204", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/license-key/windows/win2011/",
+				httpmock.NewStringResponder(http.StatusNoContent, ""))
+			if err := keyClient.Delete("windows", "win2011"); err != nil {
+				t.Fatal(err)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/license-key/windows/win2012/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if err := keyClient.Delete("windows", "win2012"); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		want := new(entity.LicenseKey)
+		if err := helper.TestdataFromJSON("maas/license_key.json", want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/license-key/windows/win2013/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := keyClient.Get("windows", "win2013")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := new(entity.LicenseKey)
+			if err := helper.TestdataFromJSON("maas/license_key.json", want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/license-key/windows/win2014/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			res, err := keyClient.Put("windows", "win2014", &params.LicenseKey{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, res, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/license-key/windows/win2015/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			got, err := keyClient.Put("windows", "win2015", &params.LicenseKey{})
+			if diff := cmp.Diff((&entity.LicenseKey{}), got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+			if err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+}
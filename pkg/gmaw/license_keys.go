@@ -0,0 +1,49 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// LicenseKeys provides methods for the License Keys operations in the MaaS API.
+// This type should be instantiated via NewLicenseKeys(). It fulfills the
+// api.LicenseKeys interface.
+type LicenseKeys struct {
+	client Client
+}
+
+// NewLicenseKeys configures a new LicenseKeys.
+func NewLicenseKeys(client *gomaasapi.MAASObject) *LicenseKeys {
+	c := client.GetSubObject("license-keys")
+	return &LicenseKeys{client: Client{&c}}
+}
+
+// Get returns information about all of the license keys.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (l *LicenseKeys) Get() (keys []entity.LicenseKey, err error) {
+	err = l.client.Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, &keys)
+	})
+	return
+}
+
+// Post adds a license key for an OS release and returns information about the new license key.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (l *LicenseKeys) Post(p *params.LicenseKey) (key *entity.LicenseKey, err error) {
+	key = new(entity.LicenseKey)
+	qsp := url.Values{
+		"osystem":       {p.OSystem},
+		"distro_series": {p.DistroSeries},
+		"license_key":   {p.LicenseKey},
+	}
+	err = l.client.Post("", qsp, func(data []byte) error {
+		return json.Unmarshal(data, key)
+	})
+	return
+}
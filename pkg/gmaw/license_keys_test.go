@@ -0,0 +1,63 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewLicenseKeys(t *testing.T) {
+	NewLicenseKeys(client)
+}
+
+func TestLicenseKeys(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.LicenseKeys = (*LicenseKeys)(nil)
+
+	// Create a new license keys client to be used in the tests
+	keysClient := NewLicenseKeys(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		var keys []entity.LicenseKey
+		if err := helper.TestdataFromJSON("maas/license_keys.json", &keys); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/license-keys/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, keys))
+		res, err := keysClient.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(keys, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(LicenseKeys) mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("Post", func(t *testing.T) {
+		t.Parallel()
+		key := new(entity.LicenseKey)
+		if err := helper.TestdataFromJSON("maas/license_key.json", key); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/license-keys/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, key))
+
+		p := &params.LicenseKey{OSystem: key.OSystem, DistroSeries: key.DistroSeries, LicenseKey: key.LicenseKey}
+		res, err := keysClient.Post(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(key, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(LicenseKeys) mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -20,12 +20,13 @@ func NewMAASServer(client *gomaasapi.MAASObject) *MAASServer {
 	return &MAASServer{client: Client{&c}}
 }
 
-// Get returns the value of the configuration key <name>.
+// Get returns the value of the configuration key <name>, as the JSON encoded
+// value returned by the API (eg a quoted string, a boolean or null).
 // This function returns an error if the gomaasapi returns an error.
 func (m *MAASServer) Get(name string) (res string, err error) {
 	qsp := url.Values{}
 	qsp.Set("name", name)
-	err = m.client.Get("", qsp, func(data []byte) error {
+	err = m.client.Get("get_config", qsp, func(data []byte) error {
 		res = string(data)
 		return nil
 	})
@@ -38,7 +39,7 @@ func (m *MAASServer) Post(name, value string) (err error) {
 	qsp := url.Values{}
 	qsp.Set("name", name)
 	qsp.Set("value", value)
-	err = m.client.Post("", qsp, func(data []byte) error {
+	err = m.client.Post("set_config", qsp, func(data []byte) error {
 		if res := string(data); res != "OK" {
 			return fmt.Errorf("unexpected server response '%s' (expected 'OK')", res)
 		}
@@ -25,8 +25,8 @@ func TestMAASServer(t *testing.T) {
 
 	t.Run("Get", func(t *testing.T) {
 		t.Parallel()
-		want := "the_value"
-		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/maas/",
+		want := `"the_value"`
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/maas/?name=the_key&op=get_config",
 			httpmock.NewStringResponder(http.StatusOK, want))
 		got, err := maasClient.Get("the_key")
 		if err != nil {
@@ -38,7 +38,7 @@ func TestMAASServer(t *testing.T) {
 	})
 	t.Run("Post", func(t *testing.T) {
 		t.Parallel()
-		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/maas/",
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/maas/?op=set_config",
 			httpmock.NewStringResponder(http.StatusOK, "OK"))
 
 		err := maasClient.Post("key", "value")
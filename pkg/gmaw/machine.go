@@ -1,9 +1,12 @@
 package gmaw
 
 import (
+	"net/http"
 	"net/url"
+	"strconv"
 
 	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
 	"github.com/roblox/terraform-provider-maas/pkg/maas"
 )
 
@@ -35,6 +38,32 @@ func (m *Machine) callPost(systemID, op string, qsp url.Values) ([]byte, error)
 	return res.GetBytes()
 }
 
+// callGet returns the raw response from the MAAS API and any errors.
+// This method creates the appropriate MAASObject for the API call, invokes the
+// CallGet function, and returns the GetBytes() method of the response. It will
+// return a nil byte array if CallGet returns an error.
+func (m *Machine) callGet(systemID, op string, qsp url.Values) ([]byte, error) {
+	mc := m.client.GetSubObject("machines").GetSubObject(systemID)
+	res, err := mc.CallGet(op, qsp)
+	if err != nil {
+		return nil, err
+	}
+	return res.GetBytes()
+}
+
+// callPut returns the raw response from the MAAS API and any errors.
+// This method creates the appropriate MAASObject for the API call, invokes the
+// Update function, and returns the JSON representation of the response. It will
+// return a nil byte array if Update returns an error.
+func (m *Machine) callPut(systemID string, qsp url.Values) ([]byte, error) {
+	mc := m.client.GetSubObject("machines").GetSubObject(systemID)
+	res, err := mc.Update(qsp)
+	if err != nil {
+		return nil, err
+	}
+	return res.MarshalJSON()
+}
+
 // Get fulfills the maas.MachineFetcher interface
 func (m *Machine) Get(systemID string) ([]byte, error) {
 	mc := m.client.GetSubObject("machines").GetSubObject(systemID)
@@ -53,9 +82,95 @@ func (m *Machine) Commission(systemID string, params maas.MachineCommissionParam
 }
 
 // Deploy fulfills the maas.MachineFetcher interface
+// The deploy operation does not take kernel options, so they are set on the machine's
+// DeployKernelOptionsTag, which is created if needed, before the machine is deployed.
+// The tag is deleted again if the deploy operation fails.
 func (m *Machine) Deploy(systemID string, params *maas.MachineDeployParams) ([]byte, error) {
-	qsp := maas.ToQSP(params)
-	return m.callPost(systemID, "deploy", qsp)
+	if params.KernelOptions != "" {
+		if err := m.tagKernelOptions(systemID, params.KernelOptions); err != nil {
+			return nil, err
+		}
+	}
+	res, err := m.callPost(systemID, "deploy", machineDeployQSP(params))
+	if err != nil && params.KernelOptions != "" {
+		m.UntagKernelOptions(systemID) // nolint: errcheck
+	}
+	return res, err
+}
+
+// DeployKernelOptionsTag returns the name of the tag that holds the kernel options given
+// to the deploy of the machine with <systemID>. The tag is kept while the machine is
+// deployed, since the machine boots with the options, and should be deleted with
+// UntagKernelOptions once the machine is released.
+func DeployKernelOptionsTag(systemID string) string {
+	return "deploy-kernel-opts-" + systemID
+}
+
+// UntagKernelOptions deletes the machine's DeployKernelOptionsTag. A machine that was
+// deployed without kernel options has no such tag, which is not an error.
+func (m *Machine) UntagKernelOptions(systemID string) error {
+	err := NewTag(m.client).Delete(DeployKernelOptionsTag(systemID))
+	if serverErr, ok := gomaasapi.GetServerError(err); ok && serverErr.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
+
+// tagKernelOptions sets the kernel options of the machine's DeployKernelOptionsTag,
+// creating the tag if it does not exist yet, and adds the machine to it.
+func (m *Machine) tagKernelOptions(systemID, kernelOptions string) error {
+	name := DeployKernelOptionsTag(systemID)
+	p := &params.Tag{
+		Name:       name,
+		Comment:    "Kernel options for the deploy of " + systemID,
+		KernelOpts: kernelOptions,
+	}
+	if _, err := NewTag(m.client).Put(name, p); err != nil {
+		serverErr, ok := gomaasapi.GetServerError(err)
+		if !ok || serverErr.StatusCode != http.StatusNotFound {
+			return err
+		}
+		if _, err := NewTags(m.client).Post(p); err != nil {
+			return err
+		}
+	}
+	_, _, err := NewTag(m.client).UpdateNodes(name, &params.TagUpdateNodes{Add: []string{systemID}})
+	return err
+}
+
+// machineDeployQSP returns the query string parameters for the deploy operation.
+// Only the parameters that are set are included, so that MaaS uses its defaults for the rest.
+func machineDeployQSP(params *maas.MachineDeployParams) url.Values {
+	qsp := make(url.Values)
+	for key, val := range map[string]string{
+		"user_data":     params.UserData,
+		"osystem":       params.OSystem,
+		"distro_series": params.DistroSeries,
+		"hwe_kernel":    params.HWEKernel,
+		"license_key":   params.LicenseKey,
+		"agent_name":    params.AgentName,
+		"comment":       params.Comment,
+	} {
+		if val != "" {
+			qsp.Set(key, val)
+		}
+	}
+	if params.BridgeFD != 0 {
+		qsp.Set("bridge_fd", strconv.Itoa(params.BridgeFD))
+	}
+	for key, val := range map[string]bool{
+		"bridge_all":       params.BridgeAll,
+		"bridge_stp":       params.BridgeSTP,
+		"install_rackd":    params.InstallRackD,
+		"install_kvm":      params.InstallKVM,
+		"ephemeral_deploy": params.EphemeralDeploy,
+		"enable_hw_sync":   params.EnableHWSync,
+	} {
+		if val {
+			qsp.Set(key, "true")
+		}
+	}
+	return qsp
 }
 
 // Lock fulfills the maas.MachineFetcher interface
@@ -66,3 +181,106 @@ func (m *Machine) Lock(systemID, comment string) ([]byte, error) {
 	}
 	return m.callPost(systemID, "lock", qsp)
 }
+
+// Unlock fulfills the maas.MachineFetcher interface
+func (m *Machine) Unlock(systemID, comment string) ([]byte, error) {
+	qsp := make(url.Values)
+	if comment != "" {
+		qsp.Set("comment", comment)
+	}
+	return m.callPost(systemID, "unlock", qsp)
+}
+
+// Delete fulfills the maas.MachineFetcher interface
+func (m *Machine) Delete(systemID string) error {
+	return m.client.GetSubObject("machines").GetSubObject(systemID).Delete()
+}
+
+// Put fulfills the maas.MachineFetcher interface
+func (m *Machine) Put(systemID string, params maas.MachineUpdateParams) ([]byte, error) {
+	qsp := make(url.Values)
+	for key, val := range map[string]*string{
+		"hostname":       params.Hostname,
+		"domain":         params.Domain,
+		"description":    params.Description,
+		"zone":           params.Zone,
+		"pool":           params.Pool,
+		"min_hwe_kernel": params.MinHWEKernel,
+	} {
+		if val != nil {
+			qsp.Set(key, *val)
+		}
+	}
+	if params.Comment != "" {
+		qsp.Set("comment", params.Comment)
+	}
+	return m.callPut(systemID, qsp)
+}
+
+// SetOwnerData fulfills the maas.MachineFetcher interface
+func (m *Machine) SetOwnerData(systemID string, data map[string]string) ([]byte, error) {
+	qsp := make(url.Values)
+	for key, val := range data {
+		qsp.Set(key, val)
+	}
+	return m.callPost(systemID, "set_owner_data", qsp)
+}
+
+// PowerOn fulfills the maas.MachineFetcher interface
+func (m *Machine) PowerOn(systemID string, params maas.MachinePowerOnParams) ([]byte, error) {
+	qsp := make(url.Values)
+	if params.UserData != "" {
+		qsp.Set("user_data", params.UserData)
+	}
+	if params.Comment != "" {
+		qsp.Set("comment", params.Comment)
+	}
+	return m.callPost(systemID, "power_on", qsp)
+}
+
+// PowerOff fulfills the maas.MachineFetcher interface
+func (m *Machine) PowerOff(systemID string, params maas.MachinePowerOffParams) ([]byte, error) {
+	qsp := make(url.Values)
+	if params.StopMode != "" {
+		qsp.Set("stop_mode", params.StopMode)
+	}
+	if params.Comment != "" {
+		qsp.Set("comment", params.Comment)
+	}
+	return m.callPost(systemID, "power_off", qsp)
+}
+
+// QueryPowerState fulfills the maas.MachineFetcher interface
+func (m *Machine) QueryPowerState(systemID string) ([]byte, error) {
+	return m.callGet(systemID, "query_power_state", url.Values{})
+}
+
+// GetPowerParameters fulfills the maas.MachineFetcher interface
+func (m *Machine) GetPowerParameters(systemID string) ([]byte, error) {
+	return m.callGet(systemID, "power_parameters", url.Values{})
+}
+
+// SetPowerParameters fulfills the maas.MachineFetcher interface
+func (m *Machine) SetPowerParameters(systemID string, params maas.MachinePowerParams) ([]byte, error) {
+	qsp := make(url.Values)
+	if params.PowerType != "" {
+		qsp.Set("power_type", params.PowerType)
+	}
+	for key, val := range params.Parameters {
+		qsp.Set("power_parameters_"+key, val)
+	}
+	if params.SkipCheck {
+		qsp.Set("power_parameters_skip_check", "true")
+	}
+	return m.callPut(systemID, qsp)
+}
+
+// RescueMode fulfills the maas.MachineFetcher interface
+func (m *Machine) RescueMode(systemID string) ([]byte, error) {
+	return m.callPost(systemID, "rescue_mode", url.Values{})
+}
+
+// ExitRescueMode fulfills the maas.MachineFetcher interface
+func (m *Machine) ExitRescueMode(systemID string) ([]byte, error) {
+	return m.callPost(systemID, "exit_rescue_mode", url.Values{})
+}
@@ -3,9 +3,11 @@ package gmaw_test
 import (
 	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/jarcoal/httpmock"
 	"github.com/juju/gomaasapi"
 	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
@@ -74,6 +76,106 @@ func TestMachine_Deploy(t *testing.T) {
 	})
 }
 
+func TestMachine_Deploy_Params(t *testing.T) {
+	defer httpmock.Reset()
+	want := url.Values{
+		"op":            {"deploy"},
+		"osystem":       {"windows"},
+		"distro_series": {"win2016"},
+		"license_key":   {"XXXXX-XXXXX-XXXXX-XXXXX-XXXXX"},
+		"install_kvm":   {"true"},
+	}
+	httpmock.RegisterResponder("POST", apiURL+"/api/2.0/machines/46/?op=deploy",
+		func(req *http.Request) (*http.Response, error) {
+			if err := req.ParseForm(); err != nil {
+				return nil, err
+			}
+			if diff := cmp.Diff(want, req.Form); diff != "" {
+				t.Errorf("deploy parameters mismatch (-want +got):\n%s", diff)
+			}
+			return httpmock.NewStringResponse(http.StatusOK, "Machines!"), nil
+		})
+
+	params := &maas.MachineDeployParams{
+		OSystem:      "windows",
+		DistroSeries: "win2016",
+		LicenseKey:   "XXXXX-XXXXX-XXXXX-XXXXX-XXXXX",
+		InstallKVM:   true,
+	}
+	if _, err := NewMachine(client).Deploy("46", params); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMachine_Deploy_KernelOptions(t *testing.T) {
+	defer httpmock.Reset()
+	tag := DeployKernelOptionsTag("47")
+	httpmock.RegisterResponder("PUT", apiURL+"/api/2.0/tags/"+tag+"/",
+		httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+	httpmock.RegisterResponder("POST", apiURL+"/api/2.0/tags/",
+		func(req *http.Request) (*http.Response, error) {
+			if err := req.ParseForm(); err != nil {
+				return nil, err
+			}
+			if diff := cmp.Diff("isolcpus=2-15 hugepages=64", req.Form.Get("kernel_opts")); diff != "" {
+				t.Errorf("kernel options mismatch (-want +got):\n%s", diff)
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]string{"name": tag})
+		})
+	httpmock.RegisterResponder("POST", apiURL+"/api/2.0/tags/"+tag+"/?op=update_nodes",
+		httpmock.NewStringResponder(http.StatusOK, `{"added": 1, "removed": 0}`))
+	httpmock.RegisterResponder("POST", apiURL+"/api/2.0/machines/47/?op=deploy",
+		func(req *http.Request) (*http.Response, error) {
+			if err := req.ParseForm(); err != nil {
+				return nil, err
+			}
+			want := url.Values{"op": {"deploy"}, "ephemeral_deploy": {"true"}}
+			if diff := cmp.Diff(want, req.Form); diff != "" {
+				t.Errorf("deploy parameters mismatch (-want +got):\n%s", diff)
+			}
+			return httpmock.NewStringResponse(http.StatusOK, "Machines!"), nil
+		})
+
+	params := &maas.MachineDeployParams{KernelOptions: "isolcpus=2-15 hugepages=64", EphemeralDeploy: true}
+	if _, err := NewMachine(client).Deploy("47", params); err != nil {
+		t.Fatal(err)
+	}
+	info := httpmock.GetCallCountInfo()
+	if n := info["POST "+apiURL+"/api/2.0/tags/"+tag+"/?op=update_nodes"]; n != 1 {
+		t.Errorf("machine added to the kernel options tag %d times, want 1", n)
+	}
+}
+
+func TestMachine_Deploy_KernelOptionsFailure(t *testing.T) {
+	defer httpmock.Reset()
+	tag := DeployKernelOptionsTag("48")
+	httpmock.RegisterResponder("PUT", apiURL+"/api/2.0/tags/"+tag+"/",
+		httpmock.NewStringResponder(http.StatusOK, `{"name": "`+tag+`", "resource_uri": "/MAAS/api/2.0/tags/`+tag+`/"}`))
+	httpmock.RegisterResponder("POST", apiURL+"/api/2.0/tags/"+tag+"/?op=update_nodes",
+		httpmock.NewStringResponder(http.StatusOK, `{"added": 1, "removed": 0}`))
+	httpmock.RegisterResponder("POST", apiURL+"/api/2.0/machines/48/?op=deploy",
+		httpmock.NewStringResponder(http.StatusConflict, "No image available"))
+	httpmock.RegisterResponder("DELETE", apiURL+"/api/2.0/tags/"+tag+"/",
+		httpmock.NewStringResponder(http.StatusNoContent, ""))
+
+	params := &maas.MachineDeployParams{KernelOptions: "isolcpus=2-15"}
+	if _, err := NewMachine(client).Deploy("48", params); err == nil {
+		t.Fatal("Expected an error from the deploy")
+	}
+	if n := httpmock.GetCallCountInfo()["DELETE "+apiURL+"/api/2.0/tags/"+tag+"/"]; n != 1 {
+		t.Errorf("kernel options tag deleted %d times, want 1", n)
+	}
+}
+
+func TestMachine_UntagKernelOptions(t *testing.T) {
+	defer httpmock.Reset()
+	httpmock.RegisterResponder("DELETE", apiURL+"/api/2.0/tags/"+DeployKernelOptionsTag("49")+"/",
+		httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+	if err := NewMachine(client).UntagKernelOptions("49"); err != nil {
+		t.Fatalf("Expected a missing tag to be ignored, got %s", err)
+	}
+}
+
 func TestMachine_Lock(t *testing.T) {
 	tests := []testCase{
 		{URL: "machines/42/?op=lock", Verb: "POST",
@@ -88,3 +190,194 @@ func TestMachine_Lock(t *testing.T) {
 		return machine.Lock(tc.URL[9:11], "some-comment")
 	})
 }
+
+func TestMachine_Unlock(t *testing.T) {
+	tests := []testCase{
+		{URL: "machines/42/?op=unlock", Verb: "POST",
+			StatusCode: http.StatusOK, Response: "Machines!"}, // TODO Make a sample file
+		{URL: "machines/43/?op=unlock", Verb: "POST", StatusCode: http.StatusForbidden,
+			Response: "The user does not have permission to unlock the machine."},
+		{URL: "machines/44/?op=unlock", Verb: "POST", StatusCode: http.StatusNotFound, Response: "Not Found"},
+	}
+
+	machine := NewMachine(client)
+	runTestCases(t, tests, func(tc testCase) ([]byte, error) {
+		return machine.Unlock(tc.URL[9:11], "some-comment")
+	})
+}
+
+func TestMachine_Delete(t *testing.T) {
+	tests := []testCase{
+		{URL: "machines/42/", Verb: "DELETE", StatusCode: http.StatusOK, Response: ""},
+		{URL: "machines/43/", Verb: "DELETE", StatusCode: http.StatusForbidden,
+			Response: "The user does not have permission to delete this machine."},
+		{URL: "machines/44/", Verb: "DELETE", StatusCode: http.StatusNotFound, Response: "Not Found"},
+	}
+
+	machine := NewMachine(client)
+	runTestCases(t, tests, func(tc testCase) ([]byte, error) {
+		return nil, machine.Delete(tc.URL[9:11])
+	})
+}
+
+func TestMachine_Put(t *testing.T) {
+	tests := []testCase{
+		{URL: "machines/42/", Verb: "PUT", StatusCode: http.StatusOK,
+			Response: "{\n  \"resource_uri\": \"/MAAS/api/2.0/machines/42/\"\n}"},
+		{URL: "machines/43/", Verb: "PUT", StatusCode: http.StatusForbidden,
+			Response: "The user does not have permission to update this machine."},
+		{URL: "machines/44/", Verb: "PUT", StatusCode: http.StatusNotFound, Response: "Not Found"},
+	}
+
+	machine := NewMachine(client)
+	hostname, pool := "new-name", "tenant-a"
+	runTestCases(t, tests, func(tc testCase) ([]byte, error) {
+		return machine.Put(tc.URL[9:11], maas.MachineUpdateParams{Hostname: &hostname, Pool: &pool})
+	})
+}
+
+func TestMachine_Put_Clear(t *testing.T) {
+	defer httpmock.Reset()
+	want := url.Values{"description": {""}, "pool": {"tenant-b"}, "comment": {"Updated by Terraform"}}
+	httpmock.RegisterResponder("PUT", apiURL+"/api/2.0/machines/45/",
+		func(req *http.Request) (*http.Response, error) {
+			if err := req.ParseForm(); err != nil {
+				return nil, err
+			}
+			if diff := cmp.Diff(want, req.Form); diff != "" {
+				t.Errorf("update parameters mismatch (-want +got):\n%s", diff)
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{"resource_uri": "/MAAS/api/2.0/machines/45/"}`), nil
+		})
+
+	// The description is cleared, and the fields that are not set are left out
+	description, pool := "", "tenant-b"
+	params := maas.MachineUpdateParams{Description: &description, Pool: &pool, Comment: "Updated by Terraform"}
+	if _, err := NewMachine(client).Put("45", params); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMachine_SetOwnerData(t *testing.T) {
+	tests := []testCase{
+		{URL: "machines/42/?op=set_owner_data", Verb: "POST",
+			StatusCode: http.StatusOK, Response: "Machines!"}, // TODO Make a sample file
+		{URL: "machines/43/?op=set_owner_data", Verb: "POST", StatusCode: http.StatusForbidden,
+			Response: "The user does not have permission to set the owner data of this machine."},
+		{URL: "machines/44/?op=set_owner_data", Verb: "POST", StatusCode: http.StatusNotFound, Response: "Not Found"},
+	}
+
+	machine := NewMachine(client)
+	runTestCases(t, tests, func(tc testCase) ([]byte, error) {
+		return machine.SetOwnerData(tc.URL[9:11], map[string]string{"service": "web", "stale": ""})
+	})
+}
+
+func TestMachine_PowerOn(t *testing.T) {
+	tests := []testCase{
+		{URL: "machines/42/?op=power_on", Verb: "POST",
+			StatusCode: http.StatusOK, Response: "Machines!"}, // TODO Make a sample file
+		{URL: "machines/43/?op=power_on", Verb: "POST", StatusCode: http.StatusForbidden,
+			Response: "The user does not have permission to power on this machine."},
+		{URL: "machines/44/?op=power_on", Verb: "POST", StatusCode: http.StatusNotFound, Response: "Not Found"},
+		{URL: "machines/45/?op=power_on", Verb: "POST", StatusCode: http.StatusServiceUnavailable,
+			Response: "Unable to change power state to 'on' for node: no power type set."},
+	}
+
+	machine := NewMachine(client)
+	runTestCases(t, tests, func(tc testCase) ([]byte, error) {
+		return machine.PowerOn(tc.URL[9:11], maas.MachinePowerOnParams{Comment: "some-comment"})
+	})
+}
+
+func TestMachine_PowerOff(t *testing.T) {
+	tests := []testCase{
+		{URL: "machines/42/?op=power_off", Verb: "POST",
+			StatusCode: http.StatusOK, Response: "Machines!"}, // TODO Make a sample file
+		{URL: "machines/43/?op=power_off", Verb: "POST", StatusCode: http.StatusForbidden,
+			Response: "The user does not have permission to power off this machine."},
+		{URL: "machines/44/?op=power_off", Verb: "POST", StatusCode: http.StatusNotFound, Response: "Not Found"},
+		{URL: "machines/45/?op=power_off", Verb: "POST", StatusCode: http.StatusServiceUnavailable,
+			Response: "Unable to change power state to 'off' for node: no power type set."},
+	}
+
+	machine := NewMachine(client)
+	runTestCases(t, tests, func(tc testCase) ([]byte, error) {
+		return machine.PowerOff(tc.URL[9:11], maas.MachinePowerOffParams{StopMode: "soft"})
+	})
+}
+
+func TestMachine_QueryPowerState(t *testing.T) {
+	tests := []testCase{
+		{URL: "machines/42/?op=query_power_state", Verb: "GET", StatusCode: http.StatusOK, Response: `{"state": "on"}`},
+		{URL: "machines/43/?op=query_power_state", Verb: "GET", StatusCode: http.StatusNotFound, Response: "Not Found"},
+		{URL: "machines/44/?op=query_power_state", Verb: "GET", StatusCode: http.StatusServiceUnavailable,
+			Response: "Power state could not be queried: no power type set."},
+	}
+
+	machine := NewMachine(client)
+	runTestCases(t, tests, func(tc testCase) ([]byte, error) {
+		return machine.QueryPowerState(tc.URL[9:11])
+	})
+}
+
+func TestMachine_GetPowerParameters(t *testing.T) {
+	tests := []testCase{
+		{URL: "machines/42/?op=power_parameters", Verb: "GET", StatusCode: http.StatusOK,
+			Response: `{"power_address": "10.0.0.42", "power_user": "admin"}`},
+		{URL: "machines/43/?op=power_parameters", Verb: "GET", StatusCode: http.StatusNotFound, Response: "Not Found"},
+	}
+
+	machine := NewMachine(client)
+	runTestCases(t, tests, func(tc testCase) ([]byte, error) {
+		return machine.GetPowerParameters(tc.URL[9:11])
+	})
+}
+
+func TestMachine_SetPowerParameters(t *testing.T) {
+	tests := []testCase{
+		{URL: "machines/42/", Verb: "PUT", StatusCode: http.StatusOK,
+			Response: "{\n  \"resource_uri\": \"/MAAS/api/2.0/machines/42/\"\n}"},
+		{URL: "machines/43/", Verb: "PUT", StatusCode: http.StatusForbidden,
+			Response: "The user does not have permission to update this machine."},
+		{URL: "machines/44/", Verb: "PUT", StatusCode: http.StatusNotFound, Response: "Not Found"},
+	}
+
+	machine := NewMachine(client)
+	runTestCases(t, tests, func(tc testCase) ([]byte, error) {
+		return machine.SetPowerParameters(tc.URL[9:11], maas.MachinePowerParams{
+			PowerType:  "ipmi",
+			Parameters: map[string]string{"power_address": "10.0.0.42"},
+		})
+	})
+}
+
+func TestMachine_RescueMode(t *testing.T) {
+	tests := []testCase{
+		{URL: "machines/42/?op=rescue_mode", Verb: "POST",
+			StatusCode: http.StatusOK, Response: "Machines!"}, // TODO Make a sample file
+		{URL: "machines/43/?op=rescue_mode", Verb: "POST", StatusCode: http.StatusForbidden,
+			Response: "The user does not have permission to enter rescue mode on this machine."},
+		{URL: "machines/44/?op=rescue_mode", Verb: "POST", StatusCode: http.StatusNotFound, Response: "Not Found"},
+	}
+
+	machine := NewMachine(client)
+	runTestCases(t, tests, func(tc testCase) ([]byte, error) {
+		return machine.RescueMode(tc.URL[9:11])
+	})
+}
+
+func TestMachine_ExitRescueMode(t *testing.T) {
+	tests := []testCase{
+		{URL: "machines/42/?op=exit_rescue_mode", Verb: "POST",
+			StatusCode: http.StatusOK, Response: "Machines!"}, // TODO Make a sample file
+		{URL: "machines/43/?op=exit_rescue_mode", Verb: "POST", StatusCode: http.StatusForbidden,
+			Response: "The user does not have permission to exit rescue mode on this machine."},
+		{URL: "machines/44/?op=exit_rescue_mode", Verb: "POST", StatusCode: http.StatusNotFound, Response: "Not Found"},
+	}
+
+	machine := NewMachine(client)
+	runTestCases(t, tests, func(tc testCase) ([]byte, error) {
+		return machine.ExitRescueMode(tc.URL[9:11])
+	})
+}
@@ -39,9 +39,12 @@ func (m *Machines) Allocate(params *maas.MachinesAllocateParams) ([]byte, error)
 
 // Release fulfills the  maas.MachinesFetcher interface
 func (m *Machines) Release(systemIDs []string, comment string) error {
-	var qsp url.Values
+	qsp := make(url.Values)
 	for _, val := range systemIDs {
-		qsp.Add("system_id", val)
+		qsp.Add("machines", val)
+	}
+	if comment != "" {
+		qsp.Set("comment", comment)
 	}
 	_, err := m.callPost("release", qsp)
 	return err
@@ -0,0 +1,37 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jarcoal/httpmock"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+)
+
+func TestMachines_Release(t *testing.T) {
+	defer httpmock.Reset()
+	want := url.Values{
+		"op":       {"release"},
+		"machines": {"abc123", "def456"},
+		"comment":  {"Released by Terraform"},
+	}
+	httpmock.RegisterResponder("POST", apiURL+"/api/2.0/machines/?op=release",
+		func(req *http.Request) (*http.Response, error) {
+			if err := req.ParseForm(); err != nil {
+				return nil, err
+			}
+			if diff := cmp.Diff(want, req.Form); diff != "" {
+				t.Errorf("release parameters mismatch (-want +got):\n%s", diff)
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `["abc123", "def456"]`), nil
+		})
+
+	if err := NewMachines(client).Release([]string{"abc123", "def456"}, "Released by Terraform"); err != nil {
+		t.Fatal(err)
+	}
+	if n := httpmock.GetTotalCallCount(); n != 1 {
+		t.Errorf("release called %d times, want 1", n)
+	}
+}
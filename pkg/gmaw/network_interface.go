@@ -78,13 +78,33 @@ func (i *NetworkInterface) Disconnect(systemID string, id int) (ifc *entity.Netw
 func (i *NetworkInterface) LinkSubnet(systemID string, id int,
 	p *params.NetworkInterfaceLink) (ifc *entity.NetworkInterface, err error) {
 	ifc = new(entity.NetworkInterface)
-	qsp := maas.ToQSP(p)
+	qsp := networkInterfaceLinkQSP(p)
 	err = i.client(systemID, id).Post("link_subnet", qsp, func(data []byte) error {
 		return json.Unmarshal(data, ifc)
 	})
 	return
 }
 
+// networkInterfaceLinkQSP returns the query string parameters for the link_subnet operation.
+// Unset addresses are left out rather than sent as "<nil>", so that MaaS picks the IP address.
+func networkInterfaceLinkQSP(p *params.NetworkInterfaceLink) url.Values {
+	qsp := make(url.Values)
+	qsp.Set("mode", p.Mode)
+	if p.Subnet > 0 {
+		qsp.Set("subnet", strconv.Itoa(p.Subnet))
+	}
+	if p.IPAddress != nil {
+		qsp.Set("ip_address", p.IPAddress.String())
+	}
+	if p.Force {
+		qsp.Set("force", "true")
+	}
+	if p.DefaultGateway != nil {
+		qsp.Set("default_gateway", p.DefaultGateway.String())
+	}
+	return qsp
+}
+
 // RemoveTag removes the <tag> tag from the interface
 // This function returns an error if the gomaasapi returns an error or if
 // the response cannot be decoded.
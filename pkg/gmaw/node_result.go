@@ -0,0 +1,47 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// NodeResult provides methods for the Node Script Result operations in the MaaS API.
+// This type should be instantiated via NewNodeResult(). It fulfills the
+// api.NodeResult interface.
+type NodeResult struct {
+	c Client
+}
+
+// NewNodeResult configures a new NodeResult.
+func NewNodeResult(client *gomaasapi.MAASObject) *NodeResult {
+	c := client.GetSubObject("nodes")
+	return &NodeResult{c: Client{&c}}
+}
+
+// client returns a Client with the MAASObject that correlates to the correct endpoint.
+func (n *NodeResult) client(systemID string, id int) Client {
+	return n.c.GetSubObject(systemID).
+		GetSubObject("results").
+		GetSubObject(strconv.Itoa(id))
+}
+
+// Delete removes the result set with <id> from <systemID>.
+// This function returns an error if the gomaasapi returns an error.
+func (n *NodeResult) Delete(systemID string, id int) error {
+	return n.client(systemID, id).Delete()
+}
+
+// Get returns the result set with <id> from <systemID>.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (n *NodeResult) Get(systemID string, id int, p *params.NodeResultSearch) (res *entity.NodeResult, err error) {
+	res = new(entity.NodeResult)
+	err = n.client(systemID, id).Get("", nodeResultQSP(p), func(data []byte) error {
+		return json.Unmarshal(data, res)
+	})
+	return
+}
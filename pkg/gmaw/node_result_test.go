@@ -0,0 +1,78 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewNodeResult(t *testing.T) {
+	NewNodeResult(client)
+}
+
+func TestNodeResult(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.NodeResult = (*NodeResult)(nil)
+
+	// Create a new node result client to be used in the tests
+	nodeResultClient := NewNodeResult(client)
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("204", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/nodes/g8xyqs/results/198/",
+				httpmock.NewStringResponder(http.StatusNoContent, ""))
+			if err := nodeResultClient.Delete("g8xyqs", 198); err != nil {
+				t.Fatal(err)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/nodes/g8xyqs/results/0/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if err := nodeResultClient.Delete("g8xyqs", 0); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := new(entity.NodeResult)
+			if err := helper.TestdataFromJSON("maas/node_result.json", want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("GET", "/MAAS/api/2.0/nodes/g8xyqs/results/201/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			got, err := nodeResultClient.Get("g8xyqs", 201, &params.NodeResultSearch{IncludeOutput: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("GET", "/MAAS/api/2.0/nodes/g8xyqs/results/202/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			got, err := nodeResultClient.Get("g8xyqs", 202, nil)
+			if diff := cmp.Diff(&entity.NodeResult{}, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+			if err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+}
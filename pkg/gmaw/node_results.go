@@ -0,0 +1,63 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// NodeResults provides methods for the Node Script Results operations in the MaaS API.
+// This type should be instantiated via NewNodeResults(). It fulfills the
+// api.NodeResults interface.
+type NodeResults struct {
+	c Client
+}
+
+// NewNodeResults configures a new NodeResults.
+func NewNodeResults(client *gomaasapi.MAASObject) *NodeResults {
+	c := client.GetSubObject("nodes")
+	return &NodeResults{c: Client{&c}}
+}
+
+// client returns a Client with the MAASObject that correlates to the correct endpoint.
+func (n *NodeResults) client(systemID string) Client {
+	return n.c.GetSubObject(systemID).GetSubObject("results")
+}
+
+// Get returns the script results of <systemID>, narrowed down by <params>.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (n *NodeResults) Get(systemID string, p *params.NodeResultSearch) (res []entity.NodeResult, err error) {
+	qsp := nodeResultQSP(p)
+	if p != nil && p.Type != "" {
+		qsp.Set("type", p.Type)
+	}
+	if p != nil && p.HardwareType != "" {
+		qsp.Set("hardware_type", p.HardwareType)
+	}
+	err = n.client(systemID).Get("", qsp, func(data []byte) error {
+		return json.Unmarshal(data, &res)
+	})
+	return
+}
+
+// nodeResultQSP returns the query string parameters shared by the
+// NodeResults and NodeResult GET operations. The API rejects empty
+// values, so only the parameters that are set are included.
+func nodeResultQSP(p *params.NodeResultSearch) url.Values {
+	qsp := url.Values{}
+	if p == nil {
+		return qsp
+	}
+	if p.IncludeOutput {
+		qsp.Set("include_output", "1")
+	}
+	if len(p.Filters) > 0 {
+		qsp.Set("filters", strings.Join(p.Filters, ","))
+	}
+	return qsp
+}
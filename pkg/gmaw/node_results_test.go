@@ -0,0 +1,60 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewNodeResults(t *testing.T) {
+	NewNodeResults(client)
+}
+
+func TestNodeResults(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.NodeResults = (*NodeResults)(nil)
+
+	// Create a new node results client to be used in the tests
+	nodeResultsClient := NewNodeResults(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			var want []entity.NodeResult
+			if err := helper.TestdataFromJSON("maas/node_results.json", &want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("GET", "/MAAS/api/2.0/nodes/g8xyqs/results/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			got, err := nodeResultsClient.Get("g8xyqs", &params.NodeResultSearch{
+				Type:          "commissioning",
+				IncludeOutput: true,
+				Filters:       []string{"00-maas-07-block-devices"},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("GET", "/MAAS/api/2.0/nodes/xxxxxx/results/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			_, err := nodeResultsClient.Get("xxxxxx", nil)
+			if err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+}
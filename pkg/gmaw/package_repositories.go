@@ -0,0 +1,66 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// PackageRepositories provides methods for the Package Repositories operations in the MaaS API.
+// This type should be instantiated via NewPackageRepositories(). It fulfills the
+// api.PackageRepositories interface.
+type PackageRepositories struct {
+	client Client
+}
+
+// NewPackageRepositories configures a new PackageRepositories.
+func NewPackageRepositories(client *gomaasapi.MAASObject) *PackageRepositories {
+	c := client.GetSubObject("package-repositories")
+	return &PackageRepositories{client: Client{&c}}
+}
+
+// Get returns information about all of the package repositories.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (r *PackageRepositories) Get() (repos []entity.PackageRepository, err error) {
+	err = r.client.Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, &repos)
+	})
+	return
+}
+
+// Post creates a new package repository and returns information about the new repository.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (r *PackageRepositories) Post(p *params.PackageRepository) (repo *entity.PackageRepository, err error) {
+	repo = new(entity.PackageRepository)
+	err = r.client.Post("", packageRepositoryQSP(p), func(data []byte) error {
+		return json.Unmarshal(data, repo)
+	})
+	return
+}
+
+// packageRepositoryQSP returns the query string parameters for the PackageRepositories POST
+// and PackageRepository PUT operations. MaaS takes the lists as comma-separated values,
+// and empty lists are sent so that an update can clear them.
+func packageRepositoryQSP(p *params.PackageRepository) url.Values {
+	qsp := make(url.Values)
+	qsp.Set("name", p.Name)
+	qsp.Set("url", p.URL)
+	qsp.Set("key", p.Key)
+	qsp.Set("distributions", strings.Join(p.Distributions, ","))
+	qsp.Set("disabled_pockets", strings.Join(p.DisabledPockets, ","))
+	qsp.Set("disabled_components", strings.Join(p.DisabledComponents, ","))
+	qsp.Set("components", strings.Join(p.Components, ","))
+	if len(p.Arches) > 0 {
+		qsp.Set("arches", strings.Join(p.Arches, ","))
+	}
+	qsp.Set("disable_sources", strconv.FormatBool(p.DisableSources))
+	qsp.Set("enabled", strconv.FormatBool(p.Enabled))
+	return qsp
+}
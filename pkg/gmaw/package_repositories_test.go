@@ -0,0 +1,63 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewPackageRepositories(t *testing.T) {
+	NewPackageRepositories(client)
+}
+
+func TestPackageRepositories(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.PackageRepositories = (*PackageRepositories)(nil)
+
+	// Create a new package repositories client to be used in the tests
+	reposClient := NewPackageRepositories(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		var repos []entity.PackageRepository
+		if err := helper.TestdataFromJSON("maas/package_repositories.json", &repos); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/package-repositories/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, repos))
+		res, err := reposClient.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(repos, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(PackageRepositories) mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("Post", func(t *testing.T) {
+		t.Parallel()
+		repo := new(entity.PackageRepository)
+		if err := helper.TestdataFromJSON("maas/package_repository.json", repo); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/package-repositories/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, repo))
+
+		p := &params.PackageRepository{Name: repo.Name, URL: repo.URL, Components: repo.Components, Enabled: true}
+		res, err := reposClient.Post(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(repo, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(PackageRepositories) mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
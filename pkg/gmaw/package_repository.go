@@ -0,0 +1,57 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// PackageRepository provides methods for the Package Repository operations in the MaaS API.
+// This type should be instantiated via NewPackageRepository(). It fulfills the
+// api.PackageRepository interface.
+type PackageRepository struct {
+	c Client
+}
+
+// NewPackageRepository configures a new PackageRepository.
+func NewPackageRepository(client *gomaasapi.MAASObject) *PackageRepository {
+	c := client.GetSubObject("package-repositories")
+	return &PackageRepository{c: Client{&c}}
+}
+
+// client returns a Client (ie wrapped MAASOBject) for the package repository with the given ID
+func (r *PackageRepository) client(id int) Client {
+	return r.c.GetSubObject(strconv.Itoa(id))
+}
+
+// Delete removes a package repository. The default Ubuntu archives cannot be removed.
+// This function returns an error if the gomaasapi returns an error.
+func (r *PackageRepository) Delete(id int) error {
+	return r.client(id).Delete()
+}
+
+// Get returns information about a package repository.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (r *PackageRepository) Get(id int) (repo *entity.PackageRepository, err error) {
+	repo = new(entity.PackageRepository)
+	err = r.client(id).Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, repo)
+	})
+	return
+}
+
+// Put updates a package repository.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (r *PackageRepository) Put(id int, p *params.PackageRepository) (repo *entity.PackageRepository, err error) {
+	repo = new(entity.PackageRepository)
+	err = r.client(id).Put(packageRepositoryQSP(p), func(data []byte) error {
+		return json.Unmarshal(data, repo)
+	})
+	return
+}
@@ -0,0 +1,95 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewPackageRepository(t *testing.T) {
+	NewPackageRepository(client)
+}
+
+func TestPackageRepository(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.PackageRepository = (*PackageRepository)(nil)
+
+	// Create a new package repository client to be used in the tests
+	repoClient := NewPackageRepository(client)
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("204", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/package-repositories/1/",
+				httpmock.NewStringResponder(http.StatusNoContent, ""))
+			if err := repoClient.Delete(1); err != nil {
+				t.Fatal(err)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/package-repositories/2/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if err := repoClient.Delete(2); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		want := new(entity.PackageRepository)
+		if err := helper.TestdataFromJSON("maas/package_repository.json", want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/package-repositories/3/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := repoClient.Get(3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := new(entity.PackageRepository)
+			if err := helper.TestdataFromJSON("maas/package_repository.json", want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/package-repositories/4/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			res, err := repoClient.Put(4, &params.PackageRepository{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, res, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/package-repositories/5/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			got, err := repoClient.Put(5, &params.PackageRepository{})
+			if diff := cmp.Diff((&entity.PackageRepository{}), got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+			if err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+}
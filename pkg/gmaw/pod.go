@@ -0,0 +1,148 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Pod provides methods for the Pod operations in the MaaS API.
+// This type should be instantiated via NewPod(). It fulfills the
+// api.Pod interface.
+type Pod struct {
+	c Client
+}
+
+// NewPod configures a new Pod.
+func NewPod(client *gomaasapi.MAASObject) *Pod {
+	c := client.GetSubObject("pods")
+	return &Pod{c: Client{&c}}
+}
+
+// client returns a Client (ie wrapped MAASOBject) for the pod with the given ID
+func (p *Pod) client(id int) Client {
+	return p.c.GetSubObject(strconv.Itoa(id))
+}
+
+// Compose creates a machine on the pod and returns the machine's system ID and
+// resource URI. The machine is commissioned in the background.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (p *Pod) Compose(id int, pp *params.PodCompose) (ma *entity.Machine, err error) {
+	qsp := make(url.Values)
+	for key, val := range map[string]string{
+		"hostname":     pp.Hostname,
+		"domain":       pp.Domain,
+		"zone":         pp.Zone,
+		"pool":         pp.Pool,
+		"architecture": pp.Architecture,
+	} {
+		if val != "" {
+			qsp.Set(key, val)
+		}
+	}
+	if pp.Cores > 0 {
+		qsp.Set("cores", strconv.Itoa(pp.Cores))
+	}
+	if pp.Memory > 0 {
+		qsp.Set("memory", strconv.Itoa(pp.Memory))
+	}
+
+	// Disks are written as <label>:<size>(<pool>), comma separated
+	disks := make([]string, 0, len(pp.Disks))
+	for idx, disk := range pp.Disks {
+		d := fmt.Sprintf("disk%d:%d", idx, disk.Size)
+		if disk.Pool != "" {
+			d += "(" + disk.Pool + ")"
+		}
+		disks = append(disks, d)
+	}
+	if len(disks) > 0 {
+		qsp.Set("storage", strings.Join(disks, ","))
+	}
+
+	// Interfaces are written as <name>:<key>=<value>,..., semicolon separated. MaaS rejects
+	// an interface without constraints, so those are left to the defaults.
+	ifaces := make([]string, 0, len(pp.Interfaces))
+	for _, iface := range pp.Interfaces {
+		var constraints []string
+		for _, kv := range [][2]string{{"subnet_cidr", iface.SubnetCIDR}, {"space", iface.Space}, {"ip", iface.IPAddress}} {
+			if kv[1] != "" {
+				constraints = append(constraints, kv[0]+"="+kv[1])
+			}
+		}
+		if len(constraints) == 0 {
+			continue
+		}
+		ifaces = append(ifaces, iface.Name+":"+strings.Join(constraints, ","))
+	}
+	if len(ifaces) > 0 {
+		qsp.Set("interfaces", strings.Join(ifaces, ";"))
+	}
+
+	ma = new(entity.Machine)
+	err = p.client(id).Post("compose", qsp, func(data []byte) error {
+		return json.Unmarshal(data, ma)
+	})
+	return
+}
+
+// Delete removes a pod, along with the machines composed on it.
+// This function returns an error if the gomaasapi returns an error.
+func (p *Pod) Delete(id int) error {
+	return p.client(id).Delete()
+}
+
+// Get returns information about a pod.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (p *Pod) Get(id int) (pod *entity.Pod, err error) {
+	pod = new(entity.Pod)
+	err = p.client(id).Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, pod)
+	})
+	return
+}
+
+// GetParameters returns the power parameters of a pod, such as power_address.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (p *Pod) GetParameters(id int) (res map[string]interface{}, err error) {
+	err = p.client(id).Get("parameters", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, &res)
+	})
+	return
+}
+
+// Put updates the configuration of a pod. The tags, zone and pool are always
+// sent, so that removing the last tag clears the tags of the pod.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (p *Pod) Put(id int, pp *params.Pod) (pod *entity.Pod, err error) {
+	pod = new(entity.Pod)
+	qsp := podQSP(pp)
+	qsp.Set("tags", strings.Join(pp.Tags, ","))
+	qsp.Set("zone", pp.Zone)
+	qsp.Set("pool", pp.Pool)
+	err = p.client(id).Put(qsp, func(data []byte) error {
+		return json.Unmarshal(data, pod)
+	})
+	return
+}
+
+// Refresh queries the pod for its current resources and returns the updated pod.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (p *Pod) Refresh(id int) (pod *entity.Pod, err error) {
+	pod = new(entity.Pod)
+	err = p.client(id).Post("refresh", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, pod)
+	})
+	return
+}
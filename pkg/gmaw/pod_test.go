@@ -0,0 +1,194 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewPod(t *testing.T) {
+	NewPod(client)
+}
+
+func TestPod(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.Pod = (*Pod)(nil)
+
+	// Create a new pod client to be used in the tests
+	podClient := NewPod(client)
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("204", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/pods/1/",
+				httpmock.NewStringResponder(http.StatusNoContent, ""))
+			if err := podClient.Delete(1); err != nil {
+				t.Fatal(err)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/pods/2/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if err := podClient.Delete(2); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		want := new(entity.Pod)
+		if err := helper.TestdataFromJSON("maas/pod.json", want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/pods/3/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := podClient.Get(3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := new(entity.Pod)
+			if err := helper.TestdataFromJSON("maas/pod.json", want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/pods/4/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			res, err := podClient.Put(4, &params.Pod{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, res, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("ClearTags", func(t *testing.T) {
+			t.Parallel()
+			want := url.Values{"tags": {""}, "zone": {"default"}, "pool": {"default"}, "name": {"kvm01"}}
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/pods/12/",
+				func(req *http.Request) (*http.Response, error) {
+					if err := req.ParseForm(); err != nil {
+						return nil, err
+					}
+					if diff := cmp.Diff(want, req.PostForm); diff != "" {
+						t.Errorf("PUT parameters mismatch (-want +got):\n%s", diff)
+					}
+					return httpmock.NewStringResponse(http.StatusOK, `{"id": 12, "resource_uri": "/MAAS/api/2.0/pods/12/"}`), nil
+				})
+			if _, err := podClient.Put(12, &params.Pod{Name: "kvm01", Zone: "default", Pool: "default"}); err != nil {
+				t.Fatal(err)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/pods/5/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			got, err := podClient.Put(5, &params.Pod{})
+			if diff := cmp.Diff((&entity.Pod{}), got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+			if err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("Compose", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := &entity.Machine{SystemID: "4y3ha6", ResourceURI: "/MAAS/api/2.0/machines/4y3ha6/"}
+			httpmock.RegisterResponder("POST", "/MAAS/api/2.0/pods/6/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			p := &params.PodCompose{
+				Cores:      2,
+				Memory:     4096,
+				Disks:      []params.PodComposeDisk{{Size: 20}, {Size: 100, Pool: "ssd"}},
+				Interfaces: []params.PodComposeInterface{{Name: "eth0", SubnetCIDR: "10.0.0.0/24"}},
+			}
+			got, err := podClient.Compose(6, p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("POST", "/MAAS/api/2.0/pods/7/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if _, err := podClient.Compose(7, &params.PodCompose{}); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("GetParameters", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := map[string]interface{}{"power_address": "qemu+ssh://virsh@10.0.0.2/system", "power_pass": ""}
+			httpmock.RegisterResponder("GET", "/MAAS/api/2.0/pods/8/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			got, err := podClient.GetParameters(8)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("GET", "/MAAS/api/2.0/pods/9/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if _, err := podClient.GetParameters(9); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("Refresh", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := new(entity.Pod)
+			if err := helper.TestdataFromJSON("maas/pod.json", want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("POST", "/MAAS/api/2.0/pods/10/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			got, err := podClient.Refresh(10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("POST", "/MAAS/api/2.0/pods/11/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if _, err := podClient.Refresh(11); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+}
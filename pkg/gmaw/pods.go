@@ -0,0 +1,75 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Pods provides methods for the Pods operations in the MaaS API.
+// This type should be instantiated via NewPods(). It fulfills the
+// api.Pods interface.
+type Pods struct {
+	client Client
+}
+
+// NewPods configures a new Pods.
+func NewPods(client *gomaasapi.MAASObject) *Pods {
+	c := client.GetSubObject("pods")
+	return &Pods{client: Client{&c}}
+}
+
+// Get returns information about all of the registered pods.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (p *Pods) Get() (pods []entity.Pod, err error) {
+	err = p.client.Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, &pods)
+	})
+	return
+}
+
+// Post registers a new pod and returns information about the new pod.
+// MaaS connects to the pod and discovers its resources before responding.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (p *Pods) Post(pp *params.Pod) (pod *entity.Pod, err error) {
+	pod = new(entity.Pod)
+	err = p.client.Post("", podQSP(pp), func(data []byte) error {
+		return json.Unmarshal(data, pod)
+	})
+	return
+}
+
+// podQSP returns the query string parameters for the Pods POST and Pod PUT
+// operations. Only the parameters that are set are included.
+func podQSP(p *params.Pod) url.Values {
+	qsp := make(url.Values)
+	for key, val := range map[string]string{
+		"type":                 p.Type,
+		"power_address":        p.PowerAddress,
+		"power_pass":           p.PowerPass,
+		"name":                 p.Name,
+		"zone":                 p.Zone,
+		"pool":                 p.Pool,
+		"tags":                 strings.Join(p.Tags, ","),
+		"default_storage_pool": p.DefaultStoragePool,
+		"default_macvlan_mode": p.DefaultMACVLANMode,
+	} {
+		if val != "" {
+			qsp.Set(key, val)
+		}
+	}
+	if p.CPUOverCommitRatio > 0 {
+		qsp.Set("cpu_over_commit_ratio", strconv.FormatFloat(p.CPUOverCommitRatio, 'f', -1, 64))
+	}
+	if p.MemoryOverCommitRatio > 0 {
+		qsp.Set("memory_over_commit_ratio", strconv.FormatFloat(p.MemoryOverCommitRatio, 'f', -1, 64))
+	}
+	return qsp
+}
@@ -0,0 +1,63 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewPods(t *testing.T) {
+	NewPods(client)
+}
+
+func TestPods(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.Pods = (*Pods)(nil)
+
+	// Create a new pods client to be used in the tests
+	podsClient := NewPods(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		var pods []entity.Pod
+		if err := helper.TestdataFromJSON("maas/pods.json", &pods); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/pods/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, pods))
+		res, err := podsClient.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(pods, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(Pods) mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("Post", func(t *testing.T) {
+		t.Parallel()
+		pod := new(entity.Pod)
+		if err := helper.TestdataFromJSON("maas/pod.json", pod); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/pods/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, pod))
+
+		p := &params.Pod{Type: pod.Type, PowerAddress: "qemu+ssh://virsh@10.0.0.2/system", Tags: pod.Tags}
+		res, err := podsClient.Post(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(pod, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(Pods) mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// RackController provides methods for the Rack Controller operations in the MaaS API.
+// This type should be instantiated via NewRackController(). It fulfills the
+// api.RackController interface.
+type RackController struct {
+	c Client
+}
+
+// NewRackController configures a new RackController.
+func NewRackController(client *gomaasapi.MAASObject) *RackController {
+	c := client.GetSubObject("rackcontrollers")
+	return &RackController{c: Client{&c}}
+}
+
+// client returns a Client with the MAASObject that correlates to the correct endpoint.
+func (r *RackController) client(systemID string) Client {
+	return r.c.GetSubObject(systemID)
+}
+
+// Get returns information about the rack controller <systemID>, including the
+// status of its services.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (r *RackController) Get(systemID string) (ctrl *entity.RackController, err error) {
+	ctrl = new(entity.RackController)
+	err = r.client(systemID).Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, ctrl)
+	})
+	return
+}
+
+// ImportBootImages starts syncing the boot images of the region to the rack controller <systemID>.
+// This function returns an error if the gomaasapi returns an error.
+func (r *RackController) ImportBootImages(systemID string) error {
+	return r.client(systemID).Post("import_boot_images", url.Values{}, func([]byte) error { return nil })
+}
+
+// ListBootImages returns the boot images of the rack controller <systemID>, along
+// with whether they are in sync with the region.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (r *RackController) ListBootImages(systemID string) (images *entity.RackControllerBootImages, err error) {
+	images = new(entity.RackControllerBootImages)
+	err = r.client(systemID).Get("list_boot_images", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, images)
+	})
+	return
+}
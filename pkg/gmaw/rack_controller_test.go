@@ -0,0 +1,90 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewRackController(t *testing.T) {
+	NewRackController(client)
+}
+
+func TestRackController(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.RackController = (*RackController)(nil)
+
+	// Create a new rack controller client to be used in the tests
+	rackControllerClient := NewRackController(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := new(entity.RackController)
+			if err := helper.TestdataFromJSON("maas/rack_controller.json", want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("GET", "/MAAS/api/2.0/rackcontrollers/rack01/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			got, err := rackControllerClient.Get("rack01")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("GET", "/MAAS/api/2.0/rackcontrollers/rack02/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if _, err := rackControllerClient.Get("rack02"); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("ImportBootImages", func(t *testing.T) {
+		t.Parallel()
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/rackcontrollers/rack03/?op=import_boot_images",
+			httpmock.NewStringResponder(http.StatusOK, "Import of boot images started on rack03"))
+		if err := rackControllerClient.ImportBootImages("rack03"); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("ListBootImages", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := new(entity.RackControllerBootImages)
+			if err := helper.TestdataFromJSON("maas/rack_controller_boot_images.json", want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("GET", "/MAAS/api/2.0/rackcontrollers/rack04/?op=list_boot_images",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			got, err := rackControllerClient.ListBootImages("rack04")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("GET", "/MAAS/api/2.0/rackcontrollers/rack05/?op=list_boot_images",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if _, err := rackControllerClient.ListBootImages("rack05"); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+}
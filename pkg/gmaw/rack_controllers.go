@@ -22,12 +22,52 @@ func NewRackControllers(client *gomaasapi.MAASObject) *RackControllers {
 	return &RackControllers{client: Client{&c}}
 }
 
-// Get returns information about configured rack controllers.
+// DescribePowerTypes returns the power drivers supported by the rack controllers.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (s *RackControllers) DescribePowerTypes() (powerTypes []entity.PowerType, err error) {
+	err = s.client.Get("describe_power_types", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, &powerTypes)
+	})
+	return
+}
+
+// Get returns information about configured rack controllers, narrowed down by <p>.
 // This function returns an error if the gomaasapi returns an error or if
 // the response cannot be decoded.
 func (s *RackControllers) Get(p *params.RackControllerSearch) (ctrls []entity.RackController, err error) {
-	err = s.client.Get("", url.Values{}, func(data []byte) error {
+	err = s.client.Get("", rackControllerSearchQSP(p), func(data []byte) error {
 		return json.Unmarshal(data, &ctrls)
 	})
 	return
 }
+
+// ImportBootImages starts syncing the boot images of the region to all of the rack controllers.
+// This function returns an error if the gomaasapi returns an error.
+func (s *RackControllers) ImportBootImages() error {
+	return s.client.Post("import_boot_images", url.Values{}, func([]byte) error { return nil })
+}
+
+// rackControllerSearchQSP returns the query string parameters for the RackControllers
+// GET operation. The API matches empty values literally, so only the criteria that
+// are set are included.
+func rackControllerSearchQSP(p *params.RackControllerSearch) url.Values {
+	qsp := url.Values{}
+	if p == nil {
+		return qsp
+	}
+	for key, val := range map[string]string{
+		"hostname":    p.Hostname,
+		"mac_address": p.MACAddress,
+		"id":          p.SystemID,
+		"domain":      p.Domain,
+		"zone":        p.Zone,
+		"pool":        p.Pool,
+		"agent_name":  p.AgentName,
+	} {
+		if val != "" {
+			qsp.Set(key, val)
+		}
+	}
+	return qsp
+}
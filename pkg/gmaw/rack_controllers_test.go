@@ -26,6 +26,23 @@ func TestRackControllers(t *testing.T) {
 	// Create a new rackController client to be used in the tests
 	rackControllerClient := NewRackControllers(client)
 
+	t.Run("DescribePowerTypes", func(t *testing.T) {
+		t.Parallel()
+		var want []entity.PowerType
+		if err := helper.TestdataFromJSON("maas/power_types.json", &want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/rackcontrollers/?op=describe_power_types",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		res, err := rackControllerClient.DescribePowerTypes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
 	t.Run("Get", func(t *testing.T) {
 		t.Parallel()
 		var want []entity.RackController
@@ -42,4 +59,30 @@ func TestRackControllers(t *testing.T) {
 			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
 		}
 	})
+
+	t.Run("Get with criteria", func(t *testing.T) {
+		t.Parallel()
+		var want []entity.RackController
+		if err := helper.TestdataFromJSON("maas/rack_controllers.json", &want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/rackcontrollers/?mac_address=3d%3Afd%3A40%3Aef%3A70%3Ae8",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		res, err := rackControllerClient.Get(&params.RackControllerSearch{MACAddress: "3d:fd:40:ef:70:e8"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("ImportBootImages", func(t *testing.T) {
+		t.Parallel()
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/rackcontrollers/?op=import_boot_images",
+			httpmock.NewStringResponder(http.StatusOK, "Import of boot images started on all rack controllers"))
+		if err := rackControllerClient.ImportBootImages(); err != nil {
+			t.Fatal(err)
+		}
+	})
 }
@@ -0,0 +1,33 @@
+package gmaw
+
+import (
+	"encoding/json"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// RegionControllers provides methods for the Region Controllers operations in the MaaS API.
+// This type should be instantiated via NewRegionControllers(). It fulfills the
+// api.RegionControllers interface.
+type RegionControllers struct {
+	client Client
+}
+
+// NewRegionControllers configures a new RegionControllers.
+func NewRegionControllers(client *gomaasapi.MAASObject) *RegionControllers {
+	c := client.GetSubObject("regioncontrollers")
+	return &RegionControllers{client: Client{&c}}
+}
+
+// Get returns information about configured region controllers, narrowed down by <p>.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (r *RegionControllers) Get(p *params.RegionControllerSearch) (ctrls []entity.RegionController, err error) {
+	qsp := rackControllerSearchQSP((*params.RackControllerSearch)(p))
+	err = r.client.Get("", qsp, func(data []byte) error {
+		return json.Unmarshal(data, &ctrls)
+	})
+	return
+}
@@ -0,0 +1,62 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewRegionControllers(t *testing.T) {
+	NewRegionControllers(client)
+}
+
+func TestRegionControllers(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.RegionControllers = (*RegionControllers)(nil)
+
+	// Create a new region controllers client to be used in the tests
+	regionControllersClient := NewRegionControllers(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		var want []entity.RegionController
+		if err := helper.TestdataFromJSON("maas/region_controllers.json", &want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/regioncontrollers/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		res, err := regionControllersClient.Get(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Get with criteria", func(t *testing.T) {
+		t.Parallel()
+		var want []entity.RegionController
+		if err := helper.TestdataFromJSON("maas/region_controllers.json", &want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/regioncontrollers/?zone=default",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		res, err := regionControllersClient.Get(&params.RegionControllerSearch{Zone: "default"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// ResourcePool provides methods for the ResourcePool operations in the MaaS API.
+// This type should be instantiated via NewResourcePool(). It fulfills the
+// api.ResourcePool interface.
+type ResourcePool struct {
+	c Client
+}
+
+// NewResourcePool configures a new ResourcePool.
+func NewResourcePool(client *gomaasapi.MAASObject) *ResourcePool {
+	c := client.GetSubObject("resourcepool")
+	return &ResourcePool{c: Client{&c}}
+}
+
+// client returns a Client (ie wrapped MAASOBject) for the resource pool with the given ID
+func (r *ResourcePool) client(id int) Client {
+	return r.c.GetSubObject(strconv.Itoa(id))
+}
+
+// Delete removes a resource pool. The machines in the pool are moved to the default pool.
+// This function returns an error if the gomaasapi returns an error.
+func (r *ResourcePool) Delete(id int) error {
+	return r.client(id).Delete()
+}
+
+// Get returns information about a resource pool.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (r *ResourcePool) Get(id int) (pool *entity.ResourcePool, err error) {
+	pool = new(entity.ResourcePool)
+	err = r.client(id).Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, pool)
+	})
+	return
+}
+
+// Put updates the name and description of a resource pool. Both fields are
+// sent, so an empty description clears the description.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (r *ResourcePool) Put(id int, p *params.ResourcePool) (pool *entity.ResourcePool, err error) {
+	qsp := make(url.Values)
+	qsp.Set("name", p.Name)
+	qsp.Set("description", p.Description)
+	pool = new(entity.ResourcePool)
+	err = r.client(id).Put(qsp, func(data []byte) error {
+		return json.Unmarshal(data, pool)
+	})
+	return
+}
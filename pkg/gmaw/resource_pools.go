@@ -0,0 +1,49 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// ResourcePools provides methods for the ResourcePools operations in the MaaS API.
+// This type should be instantiated via NewResourcePools(). It fulfills the
+// api.ResourcePools interface.
+type ResourcePools struct {
+	client Client
+}
+
+// NewResourcePools configures a new ResourcePools.
+func NewResourcePools(client *gomaasapi.MAASObject) *ResourcePools {
+	c := client.GetSubObject("resourcepools")
+	return &ResourcePools{client: Client{&c}}
+}
+
+// Get returns information about all of the configured resource pools.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (r *ResourcePools) Get() (pools []entity.ResourcePool, err error) {
+	err = r.client.Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, &pools)
+	})
+	return
+}
+
+// Post creates a new resource pool and returns information about the new resource pool.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (r *ResourcePools) Post(p *params.ResourcePool) (pool *entity.ResourcePool, err error) {
+	qsp := make(url.Values)
+	qsp.Set("name", p.Name)
+	if p.Description != "" {
+		qsp.Set("description", p.Description)
+	}
+	pool = new(entity.ResourcePool)
+	err = r.client.Post("", qsp, func(data []byte) error {
+		return json.Unmarshal(data, pool)
+	})
+	return
+}
@@ -0,0 +1,63 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewResourcePools(t *testing.T) {
+	NewResourcePools(client)
+}
+
+func TestResourcePools(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.ResourcePools = (*ResourcePools)(nil)
+
+	// Create a new resource pools client to be used in the tests
+	poolsClient := NewResourcePools(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		var pools []entity.ResourcePool
+		if err := helper.TestdataFromJSON("maas/resource_pools.json", &pools); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/resourcepools/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, pools))
+		res, err := poolsClient.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(pools, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(ResourcePools) mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("Post", func(t *testing.T) {
+		t.Parallel()
+		pool := new(entity.ResourcePool)
+		if err := helper.TestdataFromJSON("maas/resource_pool.json", pool); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/resourcepools/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, pool))
+
+		p := &params.ResourcePool{Name: pool.Name, Description: pool.Description}
+		res, err := poolsClient.Post(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(pool, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(ResourcePools) mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
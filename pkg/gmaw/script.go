@@ -0,0 +1,86 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Script provides methods for the Script operations in the MaaS API.
+// This type should be instantiated via NewScript(). It fulfills the
+// api.Script interface.
+type Script struct {
+	c Client
+}
+
+// NewScript configures a new Script.
+func NewScript(client *gomaasapi.MAASObject) *Script {
+	c := client.GetSubObject("scripts")
+	return &Script{c: Client{&c}}
+}
+
+// client returns a Client (ie wrapped MAASOBject) for the script with the given name
+func (s *Script) client(name string) Client {
+	return s.c.GetSubObject(name)
+}
+
+// Delete removes a script. The scripts that ship with MaaS cannot be removed.
+// This function returns an error if the gomaasapi returns an error.
+func (s *Script) Delete(name string) error {
+	return s.client(name).Delete()
+}
+
+// Download returns the body of the current revision of a script.
+// This function returns an error if the gomaasapi returns an error.
+func (s *Script) Download(name string) (script []byte, err error) {
+	err = s.client(name).Get("download", url.Values{}, func(data []byte) error {
+		script = data
+		return nil
+	})
+	return
+}
+
+// Get returns information about a script, including the body of each of its revisions.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (s *Script) Get(name string) (script *entity.Script, err error) {
+	script = new(entity.Script)
+	qsp := url.Values{"include_script": {"1"}}
+	err = s.client(name).Get("", qsp, func(data []byte) error {
+		return json.Unmarshal(data, script)
+	})
+	return
+}
+
+// Put updates a script. A new revision is only added when the body of the script is set,
+// and MaaS reads it from the form data since gomaasapi cannot upload files with a PUT.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (s *Script) Put(name string, p *params.Script) (script *entity.Script, err error) {
+	script = new(entity.Script)
+	qsp := scriptQSP(p)
+	if len(p.Script) > 0 {
+		qsp.Set("script", string(p.Script))
+	}
+	err = s.client(name).Put(qsp, func(data []byte) error {
+		return json.Unmarshal(data, script)
+	})
+	return
+}
+
+// Revert restores a script to a previous revision. <to> is either the ID of the revision
+// in the history of the script or how many revisions to go back.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (s *Script) Revert(name string, to int) (script *entity.Script, err error) {
+	script = new(entity.Script)
+	qsp := url.Values{"to": {strconv.Itoa(to)}}
+	err = s.client(name).Post("revert", qsp, func(data []byte) error {
+		return json.Unmarshal(data, script)
+	})
+	return
+}
@@ -0,0 +1,126 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewScript(t *testing.T) {
+	NewScript(client)
+}
+
+func TestScript(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.Script = (*Script)(nil)
+
+	// Create a new script client to be used in the tests
+	scriptClient := NewScript(client)
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("204", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/scripts/script-1/",
+				httpmock.NewStringResponder(http.StatusNoContent, ""))
+			if err := scriptClient.Delete("script-1"); err != nil {
+				t.Fatal(err)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/scripts/script-2/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if err := scriptClient.Delete("script-2"); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("Download", func(t *testing.T) {
+		t.Parallel()
+		want := "#!/bin/bash -e\nstress-ng --vm 0 --vm-bytes 95% --timeout 1h\n"
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/scripts/script-3/?op=download",
+			httpmock.NewStringResponder(http.StatusOK, want))
+		got, err := scriptClient.Download("script-3")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, string(got)); diff != "" {
+			t.Fatalf("Download() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		want := new(entity.Script)
+		if err := helper.TestdataFromJSON("maas/script.json", want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/scripts/script-4/?include_script=1",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := scriptClient.Get("script-4")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := new(entity.Script)
+			if err := helper.TestdataFromJSON("maas/script.json", want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/scripts/script-5/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			res, err := scriptClient.Put("script-5", &params.Script{Script: want.History[0].Data})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, res, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/scripts/script-6/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			got, err := scriptClient.Put("script-6", &params.Script{})
+			if diff := cmp.Diff((&entity.Script{}), got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+			if err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("Revert", func(t *testing.T) {
+		t.Parallel()
+		want := new(entity.Script)
+		if err := helper.TestdataFromJSON("maas/script.json", want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/scripts/script-7/?op=revert",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := scriptClient.Revert("script-7", 27)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
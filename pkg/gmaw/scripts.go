@@ -0,0 +1,97 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Scripts provides methods for the Scripts operations in the MaaS API.
+// This type should be instantiated via NewScripts(). It fulfills the
+// api.Scripts interface.
+type Scripts struct {
+	client Client
+}
+
+// NewScripts configures a new Scripts.
+func NewScripts(client *gomaasapi.MAASObject) *Scripts {
+	c := client.GetSubObject("scripts")
+	return &Scripts{client: Client{&c}}
+}
+
+// Get returns information about the scripts matching <p>, or all of the scripts if <p> is nil.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (s *Scripts) Get(p *params.ScriptSearch) (scripts []entity.Script, err error) {
+	err = s.client.Get("", scriptSearchQSP(p), func(data []byte) error {
+		return json.Unmarshal(data, &scripts)
+	})
+	return
+}
+
+// Post uploads a new script and returns information about the new script.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (s *Scripts) Post(p *params.Script) (script *entity.Script, err error) {
+	script = new(entity.Script)
+	files := map[string][]byte{"script": p.Script}
+	err = s.client.PostFiles("", scriptQSP(p), files, func(data []byte) error {
+		return json.Unmarshal(data, script)
+	})
+	return
+}
+
+// scriptSearchQSP returns the query string parameters for the Scripts GET operation.
+// The API rejects empty values, so only the parameters that are set are included.
+func scriptSearchQSP(p *params.ScriptSearch) url.Values {
+	qsp := url.Values{}
+	if p == nil {
+		return qsp
+	}
+	if p.Type != "" {
+		qsp.Set("type", p.Type)
+	}
+	if p.HardwareType != "" {
+		qsp.Set("hardware_type", p.HardwareType)
+	}
+	if len(p.Filters) > 0 {
+		qsp.Set("filters", strings.Join(p.Filters, ","))
+	}
+	if p.IncludeScript {
+		qsp.Set("include_script", "1")
+	}
+	return qsp
+}
+
+// scriptQSP returns the query string parameters for the Scripts POST and Script PUT
+// operations, without the body of the script. MaaS takes the lists as comma-separated
+// values, and empty lists are sent so that an update can clear them.
+func scriptQSP(p *params.Script) url.Values {
+	qsp := make(url.Values)
+	qsp.Set("name", p.Name)
+	qsp.Set("title", p.Title)
+	qsp.Set("description", p.Description)
+	qsp.Set("tags", strings.Join(p.Tags, ","))
+	qsp.Set("for_hardware", strings.Join(p.ForHardware, ","))
+	if p.Type != "" {
+		qsp.Set("type", p.Type)
+	}
+	if p.HardwareType != "" {
+		qsp.Set("hardware_type", p.HardwareType)
+	}
+	if p.Parallel != "" {
+		qsp.Set("parallel", p.Parallel)
+	}
+	if p.Comment != "" {
+		qsp.Set("comment", p.Comment)
+	}
+	qsp.Set("timeout", strconv.Itoa(p.Timeout))
+	qsp.Set("destructive", strconv.FormatBool(p.Destructive))
+	qsp.Set("may_reboot", strconv.FormatBool(p.MayReboot))
+	return qsp
+}
@@ -0,0 +1,71 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewScripts(t *testing.T) {
+	NewScripts(client)
+}
+
+func TestScripts(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.Scripts = (*Scripts)(nil)
+
+	// Create a new scripts client to be used in the tests
+	scriptsClient := NewScripts(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		var scripts []entity.Script
+		if err := helper.TestdataFromJSON("maas/scripts.json", &scripts); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/scripts/?filters=burn-in%2Cmemory&type=testing",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, scripts))
+		res, err := scriptsClient.Get(&params.ScriptSearch{Type: "testing", Filters: []string{"burn-in", "memory"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(scripts, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(Scripts) mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("Post", func(t *testing.T) {
+		t.Parallel()
+		script := new(entity.Script)
+		if err := helper.TestdataFromJSON("maas/script.json", script); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/scripts/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, script))
+
+		p := &params.Script{
+			Name:         script.Name,
+			Script:       script.History[0].Data,
+			Type:         "testing",
+			HardwareType: "memory",
+			Parallel:     "instance",
+			Timeout:      3900,
+			Tags:         script.Tags,
+		}
+		res, err := scriptsClient.Post(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(script, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(Scripts) mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
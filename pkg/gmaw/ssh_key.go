@@ -0,0 +1,45 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// SSHKey provides methods for the SSH Key operations in the MaaS API.
+// This type should be instantiated via NewSSHKey(). It fulfills the
+// api.SSHKey interface.
+type SSHKey struct {
+	c Client
+}
+
+// NewSSHKey configures a new SSHKey.
+func NewSSHKey(client *gomaasapi.MAASObject) *SSHKey {
+	c := client.GetSubObject("sshkeys")
+	return &SSHKey{c: Client{&c}}
+}
+
+// client returns a Client (ie wrapped MAASOBject) for the SSH key with the given ID
+func (s *SSHKey) client(id int) Client {
+	return s.c.GetSubObject(strconv.Itoa(id))
+}
+
+// Delete removes an SSH key.
+// This function returns an error if the gomaasapi returns an error.
+func (s *SSHKey) Delete(id int) error {
+	return s.client(id).Delete()
+}
+
+// Get returns information about an SSH key.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (s *SSHKey) Get(id int) (sshKey *entity.SSHKey, err error) {
+	sshKey = new(entity.SSHKey)
+	err = s.client(id).Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, sshKey)
+	})
+	return
+}
@@ -0,0 +1,73 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewSSHKey(t *testing.T) {
+	NewSSHKey(client)
+}
+
+func TestSSHKey(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.SSHKey = (*SSHKey)(nil)
+
+	// Create a new SSH key client to be used in the tests
+	sshKeyClient := NewSSHKey(client)
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("204", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/sshkeys/1/",
+				httpmock.NewStringResponder(http.StatusNoContent, ""))
+			if err := sshKeyClient.Delete(1); err != nil {
+				t.Fatal(err)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/sshkeys/2/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if err := sshKeyClient.Delete(2); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := new(entity.SSHKey)
+			if err := helper.TestdataFromJSON("maas/ssh_key.json", want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("GET", "/MAAS/api/2.0/sshkeys/3/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			got, err := sshKeyClient.Get(3)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("GET", "/MAAS/api/2.0/sshkeys/4/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if _, err := sshKeyClient.Get(4); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+}
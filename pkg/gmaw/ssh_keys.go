@@ -0,0 +1,59 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// SSHKeys provides methods for the SSH Keys operations in the MaaS API.
+// The keys belong to the user the client is authenticated as.
+// This type should be instantiated via NewSSHKeys(). It fulfills the
+// api.SSHKeys interface.
+type SSHKeys struct {
+	client Client
+}
+
+// NewSSHKeys configures a new SSHKeys.
+func NewSSHKeys(client *gomaasapi.MAASObject) *SSHKeys {
+	c := client.GetSubObject("sshkeys")
+	return &SSHKeys{client: Client{&c}}
+}
+
+// Get returns all of the SSH keys of the user.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (s *SSHKeys) Get() (keys []entity.SSHKey, err error) {
+	err = s.client.Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, &keys)
+	})
+	return
+}
+
+// Post adds an SSH public key to the user and returns information about the new key.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (s *SSHKeys) Post(key string) (sshKey *entity.SSHKey, err error) {
+	qsp := make(url.Values)
+	qsp.Set("key", key)
+	sshKey = new(entity.SSHKey)
+	err = s.client.Post("", qsp, func(data []byte) error {
+		return json.Unmarshal(data, sshKey)
+	})
+	return
+}
+
+// Import adds the SSH public keys published for <keySource>, which is a Launchpad (lp:user)
+// or GitHub (gh:user) identifier, to the user and returns information about the new keys.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (s *SSHKeys) Import(keySource string) (keys []entity.SSHKey, err error) {
+	qsp := make(url.Values)
+	qsp.Set("keysource", keySource)
+	err = s.client.Post("import", qsp, func(data []byte) error {
+		return json.Unmarshal(data, &keys)
+	})
+	return
+}
@@ -0,0 +1,76 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewSSHKeys(t *testing.T) {
+	NewSSHKeys(client)
+}
+
+func TestSSHKeys(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.SSHKeys = (*SSHKeys)(nil)
+
+	// Create a new SSH keys client to be used in the tests
+	sshKeysClient := NewSSHKeys(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		var want []entity.SSHKey
+		if err := helper.TestdataFromJSON("maas/ssh_keys.json", &want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/sshkeys/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := sshKeysClient.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("Post", func(t *testing.T) {
+		t.Parallel()
+		want := new(entity.SSHKey)
+		if err := helper.TestdataFromJSON("maas/ssh_key.json", want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/sshkeys/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := sshKeysClient.Post(want.Key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("Import", func(t *testing.T) {
+		t.Parallel()
+		var want []entity.SSHKey
+		if err := helper.TestdataFromJSON("maas/ssh_keys.json", &want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/sshkeys/?op=import",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := sshKeysClient.Import("gh:bob")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,45 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// SSLKey provides methods for the SSL Key operations in the MaaS API.
+// This type should be instantiated via NewSSLKey(). It fulfills the
+// api.SSLKey interface.
+type SSLKey struct {
+	c Client
+}
+
+// NewSSLKey configures a new SSLKey.
+func NewSSLKey(client *gomaasapi.MAASObject) *SSLKey {
+	c := client.GetSubObject("sslkeys")
+	return &SSLKey{c: Client{&c}}
+}
+
+// client returns a Client (ie wrapped MAASOBject) for the SSL key with the given ID
+func (s *SSLKey) client(id int) Client {
+	return s.c.GetSubObject(strconv.Itoa(id))
+}
+
+// Delete removes an SSL key.
+// This function returns an error if the gomaasapi returns an error.
+func (s *SSLKey) Delete(id int) error {
+	return s.client(id).Delete()
+}
+
+// Get returns information about an SSL key.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (s *SSLKey) Get(id int) (sslKey *entity.SSLKey, err error) {
+	sslKey = new(entity.SSLKey)
+	err = s.client(id).Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, sslKey)
+	})
+	return
+}
@@ -0,0 +1,73 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewSSLKey(t *testing.T) {
+	NewSSLKey(client)
+}
+
+func TestSSLKey(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.SSLKey = (*SSLKey)(nil)
+
+	// Create a new SSL key client to be used in the tests
+	sslKeyClient := NewSSLKey(client)
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("204", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/sslkeys/1/",
+				httpmock.NewStringResponder(http.StatusNoContent, ""))
+			if err := sslKeyClient.Delete(1); err != nil {
+				t.Fatal(err)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/sslkeys/2/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if err := sslKeyClient.Delete(2); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := new(entity.SSLKey)
+			if err := helper.TestdataFromJSON("maas/ssl_key.json", want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("GET", "/MAAS/api/2.0/sslkeys/3/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			got, err := sslKeyClient.Get(3)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("GET", "/MAAS/api/2.0/sslkeys/4/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if _, err := sslKeyClient.Get(4); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+}
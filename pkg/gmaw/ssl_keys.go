@@ -0,0 +1,46 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// SSLKeys provides methods for the SSL Keys operations in the MaaS API.
+// The keys belong to the user the client is authenticated as.
+// This type should be instantiated via NewSSLKeys(). It fulfills the
+// api.SSLKeys interface.
+type SSLKeys struct {
+	client Client
+}
+
+// NewSSLKeys configures a new SSLKeys.
+func NewSSLKeys(client *gomaasapi.MAASObject) *SSLKeys {
+	c := client.GetSubObject("sslkeys")
+	return &SSLKeys{client: Client{&c}}
+}
+
+// Get returns all of the SSL keys of the user.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (s *SSLKeys) Get() (keys []entity.SSLKey, err error) {
+	err = s.client.Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, &keys)
+	})
+	return
+}
+
+// Post adds an SSL certificate to the user and returns information about the new key.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (s *SSLKeys) Post(key string) (sslKey *entity.SSLKey, err error) {
+	qsp := make(url.Values)
+	qsp.Set("key", key)
+	sslKey = new(entity.SSLKey)
+	err = s.client.Post("", qsp, func(data []byte) error {
+		return json.Unmarshal(data, sslKey)
+	})
+	return
+}
@@ -0,0 +1,60 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewSSLKeys(t *testing.T) {
+	NewSSLKeys(client)
+}
+
+func TestSSLKeys(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.SSLKeys = (*SSLKeys)(nil)
+
+	// Create a new SSL keys client to be used in the tests
+	sslKeysClient := NewSSLKeys(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		var want []entity.SSLKey
+		if err := helper.TestdataFromJSON("maas/ssl_keys.json", &want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/sslkeys/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := sslKeysClient.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("Post", func(t *testing.T) {
+		t.Parallel()
+		want := new(entity.SSLKey)
+		if err := helper.TestdataFromJSON("maas/ssl_key.json", want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/sslkeys/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := sslKeysClient.Post(want.Key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,94 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Tag provides methods for the Tag operations in the MaaS API.
+// This type should be instantiated via NewTag(). It fulfills the
+// api.Tag interface.
+type Tag struct {
+	c Client
+}
+
+// NewTag configures a new Tag.
+func NewTag(client *gomaasapi.MAASObject) *Tag {
+	c := client.GetSubObject("tags")
+	return &Tag{c: Client{&c}}
+}
+
+// client returns a Client (ie wrapped MAASOBject) for the tag with the given name
+func (t *Tag) client(name string) Client {
+	return t.c.GetSubObject(name)
+}
+
+// Delete removes a tag.
+// This function returns an error if the gomaasapi returns an error.
+func (t *Tag) Delete(name string) error {
+	return t.client(name).Delete()
+}
+
+// Get returns information about a tag.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (t *Tag) Get(name string) (tag *entity.Tag, err error) {
+	tag = new(entity.Tag)
+	err = t.client(name).Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, tag)
+	})
+	return
+}
+
+// GetNodes returns the nodes associated with a tag.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (t *Tag) GetNodes(name string) (nodes []entity.Node, err error) {
+	err = t.client(name).Get("nodes", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, &nodes)
+	})
+	return
+}
+
+// Put updates the configuration of a tag. Every field is sent, so empty
+// fields are cleared; changing the definition re-evaluates the tag against
+// every machine in the background.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (t *Tag) Put(name string, p *params.Tag) (tag *entity.Tag, err error) {
+	tag = new(entity.Tag)
+	err = t.client(name).Put(tagQSP(p), func(data []byte) error {
+		return json.Unmarshal(data, tag)
+	})
+	return
+}
+
+// UpdateNodes adds and removes nodes from a tag, returning the number of
+// nodes that were added and removed. Only tags without a definition can be
+// updated this way.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (t *Tag) UpdateNodes(name string, p *params.TagUpdateNodes) (added, removed int, err error) {
+	qsp := make(url.Values)
+	for _, systemID := range p.Add {
+		qsp.Add("add", systemID)
+	}
+	for _, systemID := range p.Remove {
+		qsp.Add("remove", systemID)
+	}
+	if p.RackController != "" {
+		qsp.Set("rack_controller", p.RackController)
+	}
+	res := new(struct {
+		Added   int `json:"added"`
+		Removed int `json:"removed"`
+	})
+	err = t.client(name).Post("update_nodes", qsp, func(data []byte) error {
+		return json.Unmarshal(data, res)
+	})
+	return res.Added, res.Removed, err
+}
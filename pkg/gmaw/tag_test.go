@@ -0,0 +1,151 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewTag(t *testing.T) {
+	NewTag(client)
+}
+
+func TestTag(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.Tag = (*Tag)(nil)
+
+	// Create a new tag client to be used in the tests
+	tagClient := NewTag(client)
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("204", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/tags/delete-me/",
+				httpmock.NewStringResponder(http.StatusNoContent, ""))
+			if err := tagClient.Delete("delete-me"); err != nil {
+				t.Fatal(err)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/tags/missing/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if err := tagClient.Delete("missing"); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		want := new(entity.Tag)
+		if err := helper.TestdataFromJSON("maas/tag.json", want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/tags/virtual/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := tagClient.Get("virtual")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("GetNodes", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			var want []entity.Node
+			if err := helper.TestdataFromJSON("maas/nodes.json", &want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("GET", "/MAAS/api/2.0/tags/nodes-200/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, &want))
+			got, err := tagClient.GetNodes("nodes-200")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("GET", "/MAAS/api/2.0/tags/nodes-404/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			res, err := tagClient.GetNodes("nodes-404")
+			if res != nil {
+				t.Fatal("Expected result to be nil")
+			}
+			if err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := new(entity.Tag)
+			if err := helper.TestdataFromJSON("maas/tag.json", want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/tags/put-200/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			res, err := tagClient.Put("put-200", &params.Tag{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, res, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/tags/put-404/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			got, err := tagClient.Put("put-404", &params.Tag{})
+			if diff := cmp.Diff((&entity.Tag{}), got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+			if err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("UpdateNodes", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("POST", "/MAAS/api/2.0/tags/update-200/",
+				httpmock.NewStringResponder(http.StatusOK, `{"added": 2, "removed": 1}`))
+			p := &params.TagUpdateNodes{Add: []string{"abc123", "def456"}, Remove: []string{"ghi789"}}
+			added, removed, err := tagClient.UpdateNodes("update-200", p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if added != 2 || removed != 1 {
+				t.Fatalf("UpdateNodes() = (%d, %d), want (2, 1)", added, removed)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("POST", "/MAAS/api/2.0/tags/update-404/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			_, _, err := tagClient.UpdateNodes("update-404", &params.TagUpdateNodes{})
+			if err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+}
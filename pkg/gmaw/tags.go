@@ -0,0 +1,62 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Tags provides methods for the Tags operations in the MaaS API.
+// This type should be instantiated via NewTags(). It fulfills the
+// api.Tags interface.
+type Tags struct {
+	client Client
+}
+
+// NewTags configures a new Tags.
+func NewTags(client *gomaasapi.MAASObject) *Tags {
+	c := client.GetSubObject("tags")
+	return &Tags{client: Client{&c}}
+}
+
+// Get returns information about all of the configured tags.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (t *Tags) Get() (tags []entity.Tag, err error) {
+	err = t.client.Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, &tags)
+	})
+	return
+}
+
+// Post creates a new tag and returns information about the new tag.
+// Tags with a definition are applied to matching machines in the background.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (t *Tags) Post(p *params.Tag) (tag *entity.Tag, err error) {
+	qsp := make(url.Values)
+	for key, val := range tagQSP(p) {
+		if val[0] != "" {
+			qsp[key] = val
+		}
+	}
+	tag = new(entity.Tag)
+	err = t.client.Post("", qsp, func(data []byte) error {
+		return json.Unmarshal(data, tag)
+	})
+	return
+}
+
+// tagQSP returns the query string parameters for the Tags POST and
+// Tag PUT operations.
+func tagQSP(p *params.Tag) url.Values {
+	qsp := make(url.Values)
+	qsp.Set("name", p.Name)
+	qsp.Set("comment", p.Comment)
+	qsp.Set("definition", p.Definition)
+	qsp.Set("kernel_opts", p.KernelOpts)
+	return qsp
+}
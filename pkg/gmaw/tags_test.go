@@ -0,0 +1,63 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewTags(t *testing.T) {
+	NewTags(client)
+}
+
+func TestTags(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.Tags = (*Tags)(nil)
+
+	// Create a new tags client to be used in the tests
+	tagsClient := NewTags(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		var tags []entity.Tag
+		if err := helper.TestdataFromJSON("maas/tags.json", &tags); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/tags/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, tags))
+		res, err := tagsClient.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(tags, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(Tags) mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("Post", func(t *testing.T) {
+		t.Parallel()
+		tag := new(entity.Tag)
+		if err := helper.TestdataFromJSON("maas/tag.json", tag); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/tags/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, tag))
+
+		p := &params.Tag{Name: tag.Name, Definition: tag.Definition}
+		res, err := tagsClient.Post(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(tag, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(Tags) mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,44 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// User provides methods for the User operations in the MaaS API.
+// This type should be instantiated via NewUser(). It fulfills the
+// api.User interface.
+type User struct {
+	c Client
+}
+
+// NewUser configures a new User.
+func NewUser(client *gomaasapi.MAASObject) *User {
+	c := client.GetSubObject("users")
+	return &User{c: Client{&c}}
+}
+
+// client returns a Client (ie wrapped MAASOBject) for the user with the given username
+func (u *User) client(username string) Client {
+	return u.c.GetSubObject(username)
+}
+
+// Delete removes a user. Users that still own machines or other resources cannot be removed.
+// This function returns an error if the gomaasapi returns an error.
+func (u *User) Delete(username string) error {
+	return u.client(username).Delete()
+}
+
+// Get returns information about a user.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (u *User) Get(username string) (user *entity.User, err error) {
+	user = new(entity.User)
+	err = u.client(username).Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, user)
+	})
+	return
+}
@@ -0,0 +1,73 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewUser(t *testing.T) {
+	NewUser(client)
+}
+
+func TestUser(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.User = (*User)(nil)
+
+	// Create a new user client to be used in the tests
+	userClient := NewUser(client)
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("204", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/users/user1/",
+				httpmock.NewStringResponder(http.StatusNoContent, ""))
+			if err := userClient.Delete("user1"); err != nil {
+				t.Fatal(err)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/users/user2/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if err := userClient.Delete("user2"); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := new(entity.User)
+			if err := helper.TestdataFromJSON("maas/user.json", want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("GET", "/MAAS/api/2.0/users/user3/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			got, err := userClient.Get("user3")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("GET", "/MAAS/api/2.0/users/user4/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if _, err := userClient.Get("user4"); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+}
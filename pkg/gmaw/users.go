@@ -0,0 +1,62 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Users provides methods for the Users operations in the MaaS API.
+// This type should be instantiated via NewUsers(). It fulfills the
+// api.Users interface.
+type Users struct {
+	client Client
+}
+
+// NewUsers configures a new Users.
+func NewUsers(client *gomaasapi.MAASObject) *Users {
+	c := client.GetSubObject("users")
+	return &Users{client: Client{&c}}
+}
+
+// Get returns information about all of the users.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (u *Users) Get() (users []entity.User, err error) {
+	err = u.client.Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, &users)
+	})
+	return
+}
+
+// Post creates a new user and returns information about the new user.
+// Only administrators can create users.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (u *Users) Post(p *params.User) (user *entity.User, err error) {
+	qsp := make(url.Values)
+	qsp.Set("username", p.Username)
+	qsp.Set("email", p.Email)
+	qsp.Set("password", p.Password)
+	qsp.Set("is_superuser", strconv.FormatBool(p.IsSuperuser))
+	user = new(entity.User)
+	err = u.client.Post("", qsp, func(data []byte) error {
+		return json.Unmarshal(data, user)
+	})
+	return
+}
+
+// WhoAmI returns information about the user the client is authenticated as.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (u *Users) WhoAmI() (user *entity.User, err error) {
+	user = new(entity.User)
+	err = u.client.Get("whoami", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, user)
+	})
+	return
+}
@@ -0,0 +1,77 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewUsers(t *testing.T) {
+	NewUsers(client)
+}
+
+func TestUsers(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.Users = (*Users)(nil)
+
+	// Create a new users client to be used in the tests
+	usersClient := NewUsers(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		var want []entity.User
+		if err := helper.TestdataFromJSON("maas/users.json", &want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/users/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := usersClient.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("Post", func(t *testing.T) {
+		t.Parallel()
+		want := new(entity.User)
+		if err := helper.TestdataFromJSON("maas/user.json", want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/users/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := usersClient.Post(&params.User{Username: want.Username, Email: want.Email, Password: "s3cret"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("WhoAmI", func(t *testing.T) {
+		t.Parallel()
+		want := new(entity.User)
+		if err := helper.TestdataFromJSON("maas/user.json", want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/users/?op=whoami",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := usersClient.WhoAmI()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
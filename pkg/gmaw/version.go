@@ -0,0 +1,33 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Version provides methods for the Version operations in the MaaS API.
+// This type should be instantiated via NewVersion(). It fulfills the
+// api.Version interface.
+type Version struct {
+	client Client
+}
+
+// NewVersion configures a new Version.
+func NewVersion(client *gomaasapi.MAASObject) *Version {
+	c := client.GetSubObject("version")
+	return &Version{client: Client{&c}}
+}
+
+// Get returns the version and capabilities of the MaaS server.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (v *Version) Get() (version *entity.Version, err error) {
+	version = new(entity.Version)
+	err = v.client.Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, version)
+	})
+	return
+}
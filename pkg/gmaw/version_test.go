@@ -0,0 +1,44 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewVersion(t *testing.T) {
+	NewVersion(client)
+}
+
+func TestVersion(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.Version = (*Version)(nil)
+
+	// Create a new version client to be used in the tests
+	versionClient := NewVersion(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		want := new(entity.Version)
+		if err := helper.TestdataFromJSON("maas/version.json", want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/version/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := versionClient.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
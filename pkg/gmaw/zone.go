@@ -0,0 +1,60 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Zone provides methods for the Zone operations in the MaaS API.
+// This type should be instantiated via NewZone(). It fulfills the
+// api.Zone interface.
+type Zone struct {
+	c Client
+}
+
+// NewZone configures a new Zone.
+func NewZone(client *gomaasapi.MAASObject) *Zone {
+	c := client.GetSubObject("zones")
+	return &Zone{c: Client{&c}}
+}
+
+// client returns a Client (ie wrapped MAASOBject) for the zone with the given name
+func (z *Zone) client(name string) Client {
+	return z.c.GetSubObject(name)
+}
+
+// Delete removes a zone. The machines in the zone are moved to the default zone.
+// This function returns an error if the gomaasapi returns an error.
+func (z *Zone) Delete(name string) error {
+	return z.client(name).Delete()
+}
+
+// Get returns information about a zone.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (z *Zone) Get(name string) (zone *entity.Zone, err error) {
+	zone = new(entity.Zone)
+	err = z.client(name).Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, zone)
+	})
+	return
+}
+
+// Put updates the name and description of a zone. Both fields are sent,
+// so an empty description clears the description.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (z *Zone) Put(name string, p *params.Zone) (zone *entity.Zone, err error) {
+	qsp := make(url.Values)
+	qsp.Set("name", p.Name)
+	qsp.Set("description", p.Description)
+	zone = new(entity.Zone)
+	err = z.client(name).Put(qsp, func(data []byte) error {
+		return json.Unmarshal(data, zone)
+	})
+	return
+}
@@ -0,0 +1,95 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewZone(t *testing.T) {
+	NewZone(client)
+}
+
+func TestZone(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.Zone = (*Zone)(nil)
+
+	// Create a new zone client to be used in the tests
+	zoneClient := NewZone(client)
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("204", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/zones/delete-me/",
+				httpmock.NewStringResponder(http.StatusNoContent, ""))
+			if err := zoneClient.Delete("delete-me"); err != nil {
+				t.Fatal(err)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("DELETE", "/MAAS/api/2.0/zones/missing/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			if err := zoneClient.Delete("missing"); err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		want := new(entity.Zone)
+		if err := helper.TestdataFromJSON("maas/zone.json", want); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/zones/zone-north/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+		got, err := zoneClient.Get("zone-north")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		t.Run("200", func(t *testing.T) {
+			t.Parallel()
+			want := new(entity.Zone)
+			if err := helper.TestdataFromJSON("maas/zone.json", want); err != nil {
+				t.Fatal(err)
+			}
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/zones/put-200/",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, want))
+			res, err := zoneClient.Put("put-200", &params.Zone{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, res, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+		t.Run("404", func(t *testing.T) {
+			t.Parallel()
+			httpmock.RegisterResponder("PUT", "/MAAS/api/2.0/zones/put-404/",
+				httpmock.NewStringResponder(http.StatusNotFound, "Not Found"))
+			got, err := zoneClient.Put("put-404", &params.Zone{})
+			if diff := cmp.Diff((&entity.Zone{}), got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+			}
+			if err.Error() != "ServerError: 404 (Not Found)" {
+				t.Fatal(err)
+			}
+		})
+	})
+}
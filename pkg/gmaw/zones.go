@@ -0,0 +1,49 @@
+package gmaw
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/juju/gomaasapi"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+)
+
+// Zones provides methods for the Zones operations in the MaaS API.
+// This type should be instantiated via NewZones(). It fulfills the
+// api.Zones interface.
+type Zones struct {
+	client Client
+}
+
+// NewZones configures a new Zones.
+func NewZones(client *gomaasapi.MAASObject) *Zones {
+	c := client.GetSubObject("zones")
+	return &Zones{client: Client{&c}}
+}
+
+// Get returns information about all of the configured zones.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (z *Zones) Get() (zones []entity.Zone, err error) {
+	err = z.client.Get("", url.Values{}, func(data []byte) error {
+		return json.Unmarshal(data, &zones)
+	})
+	return
+}
+
+// Post creates a new zone and returns information about the new zone.
+// This function returns an error if the gomaasapi returns an error or if
+// the response cannot be decoded.
+func (z *Zones) Post(p *params.Zone) (zone *entity.Zone, err error) {
+	qsp := make(url.Values)
+	qsp.Set("name", p.Name)
+	if p.Description != "" {
+		qsp.Set("description", p.Description)
+	}
+	zone = new(entity.Zone)
+	err = z.client.Post("", qsp, func(data []byte) error {
+		return json.Unmarshal(data, zone)
+	})
+	return
+}
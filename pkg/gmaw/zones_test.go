@@ -0,0 +1,63 @@
+package gmaw_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/roblox/terraform-provider-maas/pkg/api"
+	"github.com/roblox/terraform-provider-maas/pkg/api/params"
+	. "github.com/roblox/terraform-provider-maas/pkg/gmaw"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNewZones(t *testing.T) {
+	NewZones(client)
+}
+
+func TestZones(t *testing.T) {
+	// Ensure the type implements the interface
+	var _ api.Zones = (*Zones)(nil)
+
+	// Create a new zones client to be used in the tests
+	zonesClient := NewZones(client)
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+		var zones []entity.Zone
+		if err := helper.TestdataFromJSON("maas/zones.json", &zones); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", "/MAAS/api/2.0/zones/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, zones))
+		res, err := zonesClient.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(zones, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(Zones) mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("Post", func(t *testing.T) {
+		t.Parallel()
+		zone := new(entity.Zone)
+		if err := helper.TestdataFromJSON("maas/zone.json", zone); err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("POST", "/MAAS/api/2.0/zones/",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, zone))
+
+		p := &params.Zone{Name: zone.Name, Description: zone.Description}
+		res, err := zonesClient.Post(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(zone, res, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("json.Decode(Zones) mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package entity
+
+// BootResource represents the MaaS BootResource endpoint.
+// The Sets field is only returned when a single boot resource is read.
+type BootResource struct {
+	ID           int                        `json:"id,omitempty"`
+	Type         string                     `json:"type,omitempty"`
+	Name         string                     `json:"name,omitempty"`
+	Architecture string                     `json:"architecture,omitempty"`
+	Subarches    string                     `json:"subarches,omitempty"`
+	Title        string                     `json:"title,omitempty"`
+	ResourceURI  string                     `json:"resource_uri,omitempty"`
+	Sets         map[string]BootResourceSet `json:"sets,omitempty"`
+}
+
+// BootResourceSet is a version of a boot resource, as returned by the BootResource endpoint
+type BootResourceSet struct {
+	Version  string                      `json:"version,omitempty"`
+	Label    string                      `json:"label,omitempty"`
+	Size     int64                       `json:"size,omitempty"`
+	Complete bool                        `json:"complete,omitempty"`
+	Progress float64                     `json:"progress,omitempty"`
+	Files    map[string]BootResourceFile `json:"files,omitempty"`
+}
+
+// BootResourceFile is a file of a boot resource set
+type BootResourceFile struct {
+	Filename string  `json:"filename,omitempty"`
+	Filetype string  `json:"filetype,omitempty"`
+	Size     int64   `json:"size,omitempty"`
+	SHA256   string  `json:"sha256,omitempty"`
+	Complete bool    `json:"complete,omitempty"`
+	Progress float64 `json:"progress,omitempty"`
+}
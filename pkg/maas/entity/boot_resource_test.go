@@ -0,0 +1,21 @@
+package entity_test
+
+import (
+	"testing"
+
+	. "github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestBootResourcet(t *testing.T) {
+	bootResource := new(BootResource)
+	bootResources := new([]BootResource)
+
+	// Unmarshal sample data into the types
+	if err := helper.TestdataFromJSON("maas/boot_resource.json", bootResource); err != nil {
+		t.Fatal(err)
+	}
+	if err := helper.TestdataFromJSON("maas/boot_resources.json", bootResources); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,12 @@
+package entity
+
+// BootSource represents the MaaS BootSource endpoint
+type BootSource struct {
+	ID              int    `json:"id,omitempty"`
+	URL             string `json:"url,omitempty"`
+	KeyringFilename string `json:"keyring_filename,omitempty"`
+	KeyringData     string `json:"keyring_data,omitempty"`
+	Created         string `json:"created,omitempty"`
+	Updated         string `json:"updated,omitempty"`
+	ResourceURI     string `json:"resource_uri,omitempty"`
+}
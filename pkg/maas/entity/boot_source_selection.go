@@ -0,0 +1,13 @@
+package entity
+
+// BootSourceSelection represents the MaaS BootSourceSelection endpoint
+type BootSourceSelection struct {
+	ID           int      `json:"id,omitempty"`
+	BootSourceID int      `json:"boot_source_id,omitempty"`
+	OS           string   `json:"os,omitempty"`
+	Release      string   `json:"release,omitempty"`
+	Arches       []string `json:"arches,omitempty"`
+	Subarches    []string `json:"subarches,omitempty"`
+	Labels       []string `json:"labels,omitempty"`
+	ResourceURI  string   `json:"resource_uri,omitempty"`
+}
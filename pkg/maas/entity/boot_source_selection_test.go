@@ -0,0 +1,21 @@
+package entity_test
+
+import (
+	"testing"
+
+	. "github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestBootSourceSelectiont(t *testing.T) {
+	bootSourceSelection := new(BootSourceSelection)
+	bootSourceSelections := new([]BootSourceSelection)
+
+	// Unmarshal sample data into the types
+	if err := helper.TestdataFromJSON("maas/boot_source_selection.json", bootSourceSelection); err != nil {
+		t.Fatal(err)
+	}
+	if err := helper.TestdataFromJSON("maas/boot_source_selections.json", bootSourceSelections); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,21 @@
+package entity_test
+
+import (
+	"testing"
+
+	. "github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestBootSourcet(t *testing.T) {
+	bootSource := new(BootSource)
+	bootSources := new([]BootSource)
+
+	// Unmarshal sample data into the types
+	if err := helper.TestdataFromJSON("maas/boot_source.json", bootSource); err != nil {
+		t.Fatal(err)
+	}
+	if err := helper.TestdataFromJSON("maas/boot_sources.json", bootSources); err != nil {
+		t.Fatal(err)
+	}
+}
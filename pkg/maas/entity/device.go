@@ -0,0 +1,25 @@
+package entity
+
+import "net"
+
+// Device represents the MaaS Device endpoint.
+// Devices are the non-machine nodes MaaS tracks addresses and DNS names for,
+// such as switches, PDUs and BMCs.
+type Device struct {
+	Domain       Domain             `json:"domain,omitempty"`
+	Zone         Zone               `json:"zone,omitempty"`
+	TagNames     []string           `json:"tag_names,omitempty"`
+	IPAddresses  []net.IP           `json:"ip_addresses,omitempty"`
+	InterfaceSet []NetworkInterface `json:"interface_set,omitempty"`
+	OwnerData    map[string]string  `json:"owner_data,omitempty"`
+	SystemID     string             `json:"system_id,omitempty"`
+	Hostname     string             `json:"hostname,omitempty"`
+	FQDN         string             `json:"fqdn,omitempty"`
+	Owner        string             `json:"owner,omitempty"`
+	Parent       string             `json:"parent,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	NodeTypeName string             `json:"node_type_name,omitempty"`
+	ResourceURI  string             `json:"resource_uri,omitempty"`
+	NodeType     int                `json:"node_type,omitempty"`
+	AddressTTL   int                `json:"address_ttl,omitempty"`
+}
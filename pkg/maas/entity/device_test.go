@@ -0,0 +1,21 @@
+package entity_test
+
+import (
+	"testing"
+
+	. "github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestDevicet(t *testing.T) {
+	device := new(Device)
+	devices := new([]Device)
+
+	// Unmarshal sample data into the types
+	if err := helper.TestdataFromJSON("maas/device.json", device); err != nil {
+		t.Fatal(err)
+	}
+	if err := helper.TestdataFromJSON("maas/devices.json", devices); err != nil {
+		t.Fatal(err)
+	}
+}
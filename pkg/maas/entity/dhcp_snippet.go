@@ -0,0 +1,24 @@
+package entity
+
+// DHCPSnippet represents the MaaS DHCPSnippet endpoint.
+// A snippet applies to all of the DHCP configuration when GlobalSnippet is set,
+// and otherwise to either its Subnet or its Node.
+type DHCPSnippet struct {
+	Node          Machine              `json:"node,omitempty"`
+	Subnet        Subnet               `json:"subnet,omitempty"`
+	History       []DHCPSnippetHistory `json:"history,omitempty"`
+	Name          string               `json:"name,omitempty"`
+	Value         string               `json:"value,omitempty"`
+	Description   string               `json:"description,omitempty"`
+	ResourceURI   string               `json:"resource_uri,omitempty"`
+	ID            int                  `json:"id,omitempty"`
+	Enabled       bool                 `json:"enabled,omitempty"`
+	GlobalSnippet bool                 `json:"global_snippet,omitempty"`
+}
+
+// DHCPSnippetHistory is consumed by DHCPSnippet{} and should not be used directly.
+type DHCPSnippetHistory struct {
+	Value   string `json:"value,omitempty"`
+	Created string `json:"created,omitempty"`
+	ID      int    `json:"id,omitempty"`
+}
@@ -0,0 +1,21 @@
+package entity_test
+
+import (
+	"testing"
+
+	. "github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestDHCPSnippett(t *testing.T) {
+	dhcpSnippet := new(DHCPSnippet)
+	dhcpSnippets := new([]DHCPSnippet)
+
+	// Unmarshal sample data into the types
+	if err := helper.TestdataFromJSON("maas/dhcp_snippet.json", dhcpSnippet); err != nil {
+		t.Fatal(err)
+	}
+	if err := helper.TestdataFromJSON("maas/dhcp_snippets.json", dhcpSnippets); err != nil {
+		t.Fatal(err)
+	}
+}
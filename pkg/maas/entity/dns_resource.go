@@ -0,0 +1,22 @@
+package entity
+
+import "net"
+
+// DNSResource represents the MaaS DNSResource endpoint.
+type DNSResource struct {
+	IPAddresses     []DNSResourceIPAddress `json:"ip_addresses,omitempty"`
+	ResourceRecords []DNSResourceRecord    `json:"resource_records,omitempty"`
+	FQDN            string                 `json:"fqdn,omitempty"`
+	ResourceURI     string                 `json:"resource_uri,omitempty"`
+	ID              int                    `json:"id,omitempty"`
+	AddressTTL      int                    `json:"address_ttl,omitempty"`
+}
+
+// DNSResourceIPAddress represents an IP address of a DNSResource.
+// This type should not be used directly.
+type DNSResourceIPAddress struct {
+	IP            net.IP `json:"ip,omitempty"`
+	AllocTypeName string `json:"alloc_type_name,omitempty"`
+	Created       string `json:"created,omitempty"`
+	AllocType     int    `json:"alloc_type,omitempty"`
+}
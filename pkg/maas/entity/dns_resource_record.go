@@ -0,0 +1,11 @@
+package entity
+
+// DNSResourceRecord represents the MaaS DNSResourceRecord endpoint.
+type DNSResourceRecord struct {
+	FQDN        string `json:"fqdn,omitempty"`
+	RRType      string `json:"rrtype,omitempty"`
+	RRData      string `json:"rrdata,omitempty"`
+	ResourceURI string `json:"resource_uri,omitempty"`
+	ID          int    `json:"id,omitempty"`
+	TTL         int    `json:"ttl,omitempty"`
+}
@@ -0,0 +1,21 @@
+package entity_test
+
+import (
+	"testing"
+
+	. "github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestDNSResourceRecordt(t *testing.T) {
+	record := new(DNSResourceRecord)
+	records := new([]DNSResourceRecord)
+
+	// Unmarshal sample data into the types
+	if err := helper.TestdataFromJSON("maas/dns_resource_record.json", record); err != nil {
+		t.Fatal(err)
+	}
+	if err := helper.TestdataFromJSON("maas/dns_resource_records.json", records); err != nil {
+		t.Fatal(err)
+	}
+}
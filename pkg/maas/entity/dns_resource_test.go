@@ -0,0 +1,21 @@
+package entity_test
+
+import (
+	"testing"
+
+	. "github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestDNSResourcet(t *testing.T) {
+	res := new(DNSResource)
+	resources := new([]DNSResource)
+
+	// Unmarshal sample data into the types
+	if err := helper.TestdataFromJSON("maas/dns_resource.json", res); err != nil {
+		t.Fatal(err)
+	}
+	if err := helper.TestdataFromJSON("maas/dns_resources.json", resources); err != nil {
+		t.Fatal(err)
+	}
+}
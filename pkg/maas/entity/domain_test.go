@@ -8,7 +8,13 @@ import (
 )
 
 func TestDomaint(t *testing.T) {
+	domain := new(Domain)
 	domains := new([]Domain)
+
+	// Unmarshal sample data into the types
+	if err := helper.TestdataFromJSON("maas/domain.json", domain); err != nil {
+		t.Fatal(err)
+	}
 	if err := helper.TestdataFromJSON("maas/domains.json", domains); err != nil {
 		t.Fatal(err)
 	}
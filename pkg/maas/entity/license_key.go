@@ -0,0 +1,10 @@
+package entity
+
+// LicenseKey represents the MaaS LicenseKey endpoint.
+// MaaS uses the license key of an OS release when deploying it, unless one is given to the deploy.
+type LicenseKey struct {
+	OSystem      string `json:"osystem,omitempty"`
+	DistroSeries string `json:"distro_series,omitempty"`
+	LicenseKey   string `json:"license_key,omitempty"`
+	ResourceURI  string `json:"resource_uri,omitempty"`
+}
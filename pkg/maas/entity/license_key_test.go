@@ -0,0 +1,21 @@
+package entity_test
+
+import (
+	"testing"
+
+	. "github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestLicenseKeyt(t *testing.T) {
+	key := new(LicenseKey)
+	keys := new([]LicenseKey)
+
+	// Unmarshal sample data into the types
+	if err := helper.TestdataFromJSON("maas/license_key.json", key); err != nil {
+		t.Fatal(err)
+	}
+	if err := helper.TestdataFromJSON("maas/license_keys.json", keys); err != nil {
+		t.Fatal(err)
+	}
+}
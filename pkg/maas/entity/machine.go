@@ -37,7 +37,7 @@ type Machine struct {
 	PhysicalBlockDeviceSet       []BlockDevice       `json:"physicalblockdevice_set,omitempty"`
 	ISCSIBlockDeviceSet          []BlockDevice       `json:"iscsiblockdevice_set,omitempty"`
 	VirtualBlockDeviceSet        []BlockDevice       `json:"virtualblockdevice_set,omitempty"`
-	CurrentInstallationResultID  string              `json:"current_installation_result_id,omitempty"`
+	CurrentInstallationResultID  int                 `json:"current_installation_result_id,omitempty"`
 	FQDN                         string              `json:"fqdn,omitempty"`
 	DistroSeries                 string              `json:"distro_series,omitempty"`
 	MinHWEKernel                 string              `json:"min_hwe_kernel,omitempty"`
@@ -53,7 +53,7 @@ type Machine struct {
 	PowerState                   string              `json:"power_state,omitempty"`
 	MemoryTestStatusName         string              `json:"memory_test_status_name,omitempty"`
 	PowerType                    string              `json:"power_type,omitempty"`
-	OwnerData                    interface{}         `json:"owner_data,omitempty"`
+	OwnerData                    map[string]string   `json:"owner_data,omitempty"`
 	Hostname                     string              `json:"hostname,omitempty"`
 	Description                  string              `json:"description,omitempty"`
 	StatusAction                 string              `json:"status_action,omitempty"`
@@ -0,0 +1,47 @@
+package entity
+
+// NodeResult represents the MaaS Node Script Result endpoint.
+// Each NodeResult is a set of script results for a single commissioning,
+// testing, or installation run on the node.
+type NodeResult struct {
+	Results     []NodeResultScript `json:"results,omitempty"`
+	SystemID    string             `json:"system_id,omitempty"`
+	TypeName    string             `json:"type_name,omitempty"`
+	LastPing    string             `json:"last_ping,omitempty"`
+	StatusName  string             `json:"status_name,omitempty"`
+	Started     string             `json:"started,omitempty"`
+	Ended       string             `json:"ended,omitempty"`
+	Runtime     string             `json:"runtime,omitempty"`
+	ResourceURI string             `json:"resource_uri,omitempty"`
+	ID          int                `json:"id,omitempty"`
+	Type        int                `json:"type,omitempty"`
+	Status      int                `json:"status,omitempty"`
+}
+
+// NodeResultScript represents an element of the "results" object in a NodeResult.
+// The Output, Stdout, Stderr, and Result fields are base64 encoded by the API
+// and are only present if the output was requested; they are decoded by
+// json.Unmarshal. This type should not be used directly.
+type NodeResultScript struct {
+	Parameters       map[string]interface{} `json:"parameters,omitempty"`
+	Output           []byte                 `json:"output,omitempty"`
+	Stdout           []byte                 `json:"stdout,omitempty"`
+	Stderr           []byte                 `json:"stderr,omitempty"`
+	Result           []byte                 `json:"result,omitempty"`
+	Name             string                 `json:"name,omitempty"`
+	Created          string                 `json:"created,omitempty"`
+	Updated          string                 `json:"updated,omitempty"`
+	StatusName       string                 `json:"status_name,omitempty"`
+	Started          string                 `json:"started,omitempty"`
+	Ended            string                 `json:"ended,omitempty"`
+	Runtime          string                 `json:"runtime,omitempty"`
+	EstimatedRuntime string                 `json:"estimated_runtime,omitempty"`
+	StartTime        float64                `json:"starttime,omitempty"`
+	EndTime          float64                `json:"endtime,omitempty"`
+	ID               int                    `json:"id,omitempty"`
+	Status           int                    `json:"status,omitempty"`
+	ExitStatus       int                    `json:"exit_status,omitempty"`
+	ScriptID         int                    `json:"script_id,omitempty"`
+	ScriptRevisionID int                    `json:"script_revision_id,omitempty"`
+	Suppressed       bool                   `json:"suppressed,omitempty"`
+}
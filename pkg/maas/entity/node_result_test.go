@@ -0,0 +1,28 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	. "github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestNodeResultt(t *testing.T) {
+	nodeResult := new(NodeResult)
+	nodeResults := new([]NodeResult)
+
+	// Unmarshal sample data into the types
+	if err := helper.TestdataFromJSON("maas/node_result.json", nodeResult); err != nil {
+		t.Fatal(err)
+	}
+	if err := helper.TestdataFromJSON("maas/node_results.json", nodeResults); err != nil {
+		t.Fatal(err)
+	}
+
+	// The script output is base64 encoded by the API
+	if diff := cmp.Diff("Installation finished.\n", string(nodeResult.Results[0].Stdout)); diff != "" {
+		t.Fatalf("json.Decode() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,17 @@
+package entity
+
+// PackageRepository represents the MaaS PackageRepository endpoint.
+type PackageRepository struct {
+	Distributions      []string `json:"distributions,omitempty"`
+	DisabledPockets    []string `json:"disabled_pockets,omitempty"`
+	DisabledComponents []string `json:"disabled_components,omitempty"`
+	Components         []string `json:"components,omitempty"`
+	Arches             []string `json:"arches,omitempty"`
+	Name               string   `json:"name,omitempty"`
+	URL                string   `json:"url,omitempty"`
+	Key                string   `json:"key,omitempty"`
+	ResourceURI        string   `json:"resource_uri,omitempty"`
+	ID                 int      `json:"id,omitempty"`
+	DisableSources     bool     `json:"disable_sources,omitempty"`
+	Enabled            bool     `json:"enabled,omitempty"`
+}
@@ -0,0 +1,21 @@
+package entity_test
+
+import (
+	"testing"
+
+	. "github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestPackageRepositoryt(t *testing.T) {
+	repo := new(PackageRepository)
+	repos := new([]PackageRepository)
+
+	// Unmarshal sample data into the types
+	if err := helper.TestdataFromJSON("maas/package_repository.json", repo); err != nil {
+		t.Fatal(err)
+	}
+	if err := helper.TestdataFromJSON("maas/package_repositories.json", repos); err != nil {
+		t.Fatal(err)
+	}
+}
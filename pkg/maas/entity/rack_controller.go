@@ -2,3 +2,43 @@ package entity
 
 // RackController represents the MaaS RackController endpoint.
 type RackController Machine
+
+// RackControllerBootImages represents the boot images of a rack controller,
+// as returned by the list_boot_images operation of the RackController endpoint.
+// Status is one of synced, syncing, out-of-sync or unknown.
+type RackControllerBootImages struct {
+	Images    []RackControllerBootImage `json:"images,omitempty"`
+	Connected bool                      `json:"connected,omitempty"`
+	Status    string                    `json:"status,omitempty"`
+}
+
+// RackControllerBootImage is consumed by RackControllerBootImages{} and should not be used directly.
+type RackControllerBootImage struct {
+	Name         string   `json:"name,omitempty"`
+	Architecture string   `json:"architecture,omitempty"`
+	Subarches    []string `json:"subarches,omitempty"`
+}
+
+// PowerType represents a power driver, as returned by the describe_power_types
+// operation of the RackControllers endpoint.
+type PowerType struct {
+	Name            string           `json:"name,omitempty"`
+	Description     string           `json:"description,omitempty"`
+	DriverType      string           `json:"driver_type,omitempty"`
+	Fields          []PowerTypeField `json:"fields,omitempty"`
+	MissingPackages []string         `json:"missing_packages,omitempty"`
+	Chassis         bool             `json:"chassis,omitempty"`
+	CanProbe        bool             `json:"can_probe,omitempty"`
+	Queryable       bool             `json:"queryable,omitempty"`
+}
+
+// PowerTypeField is consumed by PowerType{} and should not be used directly.
+type PowerTypeField struct {
+	Name      string     `json:"name,omitempty"`
+	Label     string     `json:"label,omitempty"`
+	FieldType string     `json:"field_type,omitempty"`
+	Scope     string     `json:"scope,omitempty"`
+	Default   string     `json:"default,omitempty"`
+	Choices   [][]string `json:"choices,omitempty"`
+	Required  bool       `json:"required,omitempty"`
+}
@@ -19,3 +19,17 @@ func TestRackControllert(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestRackControllerBootImagest(t *testing.T) {
+	images := new(RackControllerBootImages)
+	if err := helper.TestdataFromJSON("maas/rack_controller_boot_images.json", images); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPowerTypet(t *testing.T) {
+	powerTypes := new([]PowerType)
+	if err := helper.TestdataFromJSON("maas/power_types.json", powerTypes); err != nil {
+		t.Fatal(err)
+	}
+}
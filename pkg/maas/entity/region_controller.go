@@ -0,0 +1,4 @@
+package entity
+
+// RegionController represents the MaaS RegionController endpoint.
+type RegionController Machine
@@ -0,0 +1,21 @@
+package entity_test
+
+import (
+	"testing"
+
+	. "github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestRegionControllert(t *testing.T) {
+	regionController := new(RegionController)
+	regionControllers := new([]RegionController)
+
+	// Unmarshal sample data into the types
+	if err := helper.TestdataFromJSON("maas/region_controller.json", regionController); err != nil {
+		t.Fatal(err)
+	}
+	if err := helper.TestdataFromJSON("maas/region_controllers.json", regionControllers); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,43 @@
+package entity
+
+// Script represents the MaaS Script endpoint.
+// Type is 0 for commissioning and 2 for testing scripts, HardwareType is one of
+// (0: node, 1: cpu, 2: memory, 3: storage, 4: network), and Parallel is one of
+// (0: disabled, 1: instance, 2: any). Timeout is formatted like "0:05:00", with
+// "0:00:00" meaning the script may run forever.
+type Script struct {
+	Parameters                map[string]interface{} `json:"parameters,omitempty"`
+	Packages                  map[string]interface{} `json:"packages,omitempty"`
+	Results                   interface{}            `json:"results,omitempty"`
+	Tags                      []string               `json:"tags,omitempty"`
+	ForHardware               []string               `json:"for_hardware,omitempty"`
+	History                   []ScriptHistory        `json:"history,omitempty"`
+	Name                      string                 `json:"name,omitempty"`
+	Title                     string                 `json:"title,omitempty"`
+	Description               string                 `json:"description,omitempty"`
+	TypeName                  string                 `json:"type_name,omitempty"`
+	HardwareTypeName          string                 `json:"hardware_type_name,omitempty"`
+	ParallelName              string                 `json:"parallel_name,omitempty"`
+	Timeout                   string                 `json:"timeout,omitempty"`
+	ResourceURI               string                 `json:"resource_uri,omitempty"`
+	ID                        int                    `json:"id,omitempty"`
+	Type                      int                    `json:"type,omitempty"`
+	HardwareType              int                    `json:"hardware_type,omitempty"`
+	Parallel                  int                    `json:"parallel,omitempty"`
+	Destructive               bool                   `json:"destructive,omitempty"`
+	Default                   bool                   `json:"default,omitempty"`
+	MayReboot                 bool                   `json:"may_reboot,omitempty"`
+	Recommission              bool                   `json:"recommission,omitempty"`
+	ApplyConfiguredNetworking bool                   `json:"apply_configured_networking,omitempty"`
+}
+
+// ScriptHistory is consumed by Script{} and should not be used directly.
+// Each element is a revision of the script, newest first. Data is base64
+// encoded by the API, is only present if the script was requested, and is
+// decoded by json.Unmarshal.
+type ScriptHistory struct {
+	Data    []byte `json:"data,omitempty"`
+	Comment string `json:"comment,omitempty"`
+	Created string `json:"created,omitempty"`
+	ID      int    `json:"id,omitempty"`
+}
@@ -0,0 +1,21 @@
+package entity_test
+
+import (
+	"testing"
+
+	. "github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestScriptt(t *testing.T) {
+	script := new(Script)
+	scripts := new([]Script)
+
+	// Unmarshal sample data into the types
+	if err := helper.TestdataFromJSON("maas/script.json", script); err != nil {
+		t.Fatal(err)
+	}
+	if err := helper.TestdataFromJSON("maas/scripts.json", scripts); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,10 @@
+package entity
+
+// SSHKey represents the MaaS SSHKey endpoint.
+// KeySource is the lp: or gh: identifier the key was imported from, if any.
+type SSHKey struct {
+	Key         string `json:"key,omitempty"`
+	KeySource   string `json:"keysource,omitempty"`
+	ResourceURI string `json:"resource_uri,omitempty"`
+	ID          int    `json:"id,omitempty"`
+}
@@ -0,0 +1,21 @@
+package entity_test
+
+import (
+	"testing"
+
+	. "github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestSSHKeyt(t *testing.T) {
+	sshKey := new(SSHKey)
+	sshKeys := new([]SSHKey)
+
+	// Unmarshal sample data into the types
+	if err := helper.TestdataFromJSON("maas/ssh_key.json", sshKey); err != nil {
+		t.Fatal(err)
+	}
+	if err := helper.TestdataFromJSON("maas/ssh_keys.json", sshKeys); err != nil {
+		t.Fatal(err)
+	}
+}
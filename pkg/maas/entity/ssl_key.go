@@ -0,0 +1,8 @@
+package entity
+
+// SSLKey represents the MaaS SSLKey endpoint.
+type SSLKey struct {
+	Key         string `json:"key,omitempty"`
+	ResourceURI string `json:"resource_uri,omitempty"`
+	ID          int    `json:"id,omitempty"`
+}
@@ -0,0 +1,21 @@
+package entity_test
+
+import (
+	"testing"
+
+	. "github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestSSLKeyt(t *testing.T) {
+	sslKey := new(SSLKey)
+	sslKeys := new([]SSLKey)
+
+	// Unmarshal sample data into the types
+	if err := helper.TestdataFromJSON("maas/ssl_key.json", sslKey); err != nil {
+		t.Fatal(err)
+	}
+	if err := helper.TestdataFromJSON("maas/ssl_keys.json", sslKeys); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,10 @@
+package entity
+
+// Tag represents the MaaS Tag endpoint.
+type Tag struct {
+	Name        string `json:"name,omitempty"`
+	Definition  string `json:"definition,omitempty"`
+	Comment     string `json:"comment,omitempty"`
+	KernelOpts  string `json:"kernel_opts,omitempty"`
+	ResourceURI string `json:"resource_uri,omitempty"`
+}
@@ -0,0 +1,20 @@
+package entity_test
+
+import (
+	"testing"
+
+	. "github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestTagt(t *testing.T) {
+	tag := new(Tag)
+	if err := helper.TestdataFromJSON("maas/tag.json", tag); err != nil {
+		t.Fatal(err)
+	}
+
+	tags := new([]Tag)
+	if err := helper.TestdataFromJSON("maas/tags.json", tags); err != nil {
+		t.Fatal(err)
+	}
+}
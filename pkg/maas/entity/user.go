@@ -0,0 +1,10 @@
+package entity
+
+// User represents the MaaS User endpoint.
+type User struct {
+	Username    string `json:"username,omitempty"`
+	Email       string `json:"email,omitempty"`
+	ResourceURI string `json:"resource_uri,omitempty"`
+	IsSuperuser bool   `json:"is_superuser,omitempty"`
+	IsLocal     bool   `json:"is_local,omitempty"`
+}
@@ -0,0 +1,21 @@
+package entity_test
+
+import (
+	"testing"
+
+	. "github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestUsert(t *testing.T) {
+	user := new(User)
+	users := new([]User)
+
+	// Unmarshal sample data into the types
+	if err := helper.TestdataFromJSON("maas/user.json", user); err != nil {
+		t.Fatal(err)
+	}
+	if err := helper.TestdataFromJSON("maas/users.json", users); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,9 @@
+package entity
+
+// Version represents the MaaS Version endpoint.
+// Version is the release of MaaS, eg "3.2.6", and Subversion identifies the build.
+type Version struct {
+	Capabilities []string `json:"capabilities,omitempty"`
+	Version      string   `json:"version,omitempty"`
+	Subversion   string   `json:"subversion,omitempty"`
+}
@@ -0,0 +1,17 @@
+package entity_test
+
+import (
+	"testing"
+
+	. "github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/test/helper"
+)
+
+func TestVersiont(t *testing.T) {
+	version := new(Version)
+
+	// Unmarshal sample data into the type
+	if err := helper.TestdataFromJSON("maas/version.json", version); err != nil {
+		t.Fatal(err)
+	}
+}
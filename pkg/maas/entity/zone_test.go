@@ -9,7 +9,13 @@ import (
 
 func TestZonet(t *testing.T) {
 	zone := new(Zone)
+	zones := new([]Zone)
+
+	// Unmarshal sample data into the types
 	if err := helper.TestdataFromJSON("maas/zone.json", zone); err != nil {
 		t.Fatal(err)
 	}
+	if err := helper.TestdataFromJSON("maas/zones.json", zones); err != nil {
+		t.Fatal(err)
+	}
 }
@@ -2,9 +2,12 @@ package maas
 
 import (
 	"encoding/json"
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/roblox/terraform-provider-maas/pkg/maas/entity"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity/node"
 )
 
 // - A proper Caretaker might be necessary here to ease the hackery in Machines.Allocate()
@@ -18,17 +21,37 @@ type MACAddress struct {
 	ResourceURI string `json:"resource_uri"`
 }
 
+// MachineInterface is a network interface of a machine, used by the Machine endpoint
+type MachineInterface struct {
+	Name  string                 `json:"name"`
+	Links []MachineInterfaceLink `json:"links"`
+}
+
+// MachineInterfaceLink is a link of a MachineInterface to a subnet
+type MachineInterfaceLink struct {
+	Mode      string `json:"mode"`
+	IPAddress string `json:"ip_address"`
+	Subnet    struct {
+		CIDR  string `json:"cidr"`
+		Space string `json:"space"`
+	} `json:"subnet"`
+}
+
 // Machine represents the Machine endpoint
 type Machine struct {
 	DeployTags             []string             `json:"deploy_tags"`
 	Tags                   []string             `json:"tags"`
 	IPAddresses            []string             `json:"ip_addresses"`
 	MACAddressSet          []MACAddress         `json:"mac_address_set"`
+	InterfaceSet           []MachineInterface   `json:"interface_set"`
 	PhysicalBlockDeviceSet []entity.BlockDevice `json:"physicalblockdevice_set"`
 	PXEMac                 []MACAddress         `json:"pxe_mac"`
 	Routers                []string             `json:"routers"`
 	TagNames               []string             `json:"tag_names"`
-	Zone                   []entity.Zone        `json:"zone"`
+	Zone                   entity.Zone          `json:"zone"`
+	Pool                   entity.ResourcePool  `json:"pool"`
+	Domain                 entity.Domain        `json:"domain"`
+	Pod                    entity.Pod           `json:"pod"`
 	Architecture           string               `json:"architecture"`
 	BootType               string               `json:"boot_type"`
 	DistroSeries           string               `json:"distro_series"`
@@ -53,6 +76,8 @@ type Machine struct {
 	ReleaseEraseSecure     bool                 `json:"release_erase_secure"`
 	ReleaseEraseQuick      bool                 `json:"release_erase_quick"`
 	Netboot                bool                 `json:"netboot"`
+	Locked                 bool                 `json:"locked"`
+	OwnerData              map[string]string    `json:"owner_data"`
 }
 
 // NewMachine converts a MAAS API JSON response into a Golang representation
@@ -148,6 +173,193 @@ func (m *MachineManager) Lock(comment string) error {
 	return err
 }
 
+// Put updates the machine via the PUT verb on the API.
+func (m *MachineManager) Put(params MachineUpdateParams) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	res, err := m.client.Put(m.SystemID(), params)
+	if err == nil {
+		err = m.appendBytes(res)
+	}
+	return err
+}
+
+// Unlock calls the unlock operation on the API.
+func (m *MachineManager) Unlock(comment string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	res, err := m.client.Unlock(m.SystemID(), comment)
+	if err == nil {
+		err = m.appendBytes(res)
+	}
+	return err
+}
+
+// Delete removes the machine from MaaS. A machine composed on a pod is
+// decomposed, which destroys the VM and frees its resources on the pod.
+func (m *MachineManager) Delete() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.client.Delete(m.SystemID())
+}
+
+// SetOwnerData calls the set_owner_data operation on the API.
+// Each key in data is set to its value, and a key with an empty value is
+// removed. Keys that are not in data are left unchanged.
+func (m *MachineManager) SetOwnerData(data map[string]string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	res, err := m.client.SetOwnerData(m.SystemID(), data)
+	if err == nil {
+		err = m.appendBytes(res)
+	}
+	return err
+}
+
+// PowerOn calls the power_on operation on the API.
+func (m *MachineManager) PowerOn(params MachinePowerOnParams) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	res, err := m.client.PowerOn(m.SystemID(), params)
+	if err == nil {
+		err = m.appendBytes(res)
+	}
+	return err
+}
+
+// PowerOff calls the power_off operation on the API.
+func (m *MachineManager) PowerOff(params MachinePowerOffParams) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	res, err := m.client.PowerOff(m.SystemID(), params)
+	if err == nil {
+		err = m.appendBytes(res)
+	}
+	return err
+}
+
+// QueryPowerState calls the query_power_state operation on the API.
+// Unlike the power_state of the Machine, which is the last known power state,
+// the returned value is the power state reported by the machine's BMC.
+func (m *MachineManager) QueryPowerState() (state string, err error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	var res []byte
+	if res, err = m.client.QueryPowerState(m.SystemID()); err != nil {
+		return
+	}
+	var ps struct {
+		State string `json:"state"`
+	}
+	err = json.Unmarshal(res, &ps)
+	return ps.State, err
+}
+
+// PowerParameters calls the power_parameters operation on the API.
+func (m *MachineManager) PowerParameters() (params map[string]interface{}, err error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	var res []byte
+	if res, err = m.client.GetPowerParameters(m.SystemID()); err == nil {
+		err = json.Unmarshal(res, &params)
+	}
+	return
+}
+
+// SetPowerParameters updates the power type and power parameters of the machine.
+func (m *MachineManager) SetPowerParameters(params MachinePowerParams) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	res, err := m.client.SetPowerParameters(m.SystemID(), params)
+	if err == nil {
+		err = m.appendBytes(res)
+	}
+	return err
+}
+
+// RescueMode calls the rescue_mode operation on the API and waits up to
+// timeout for the machine to enter rescue mode.
+func (m *MachineManager) RescueMode(timeout time.Duration) error {
+	m.mutex.Lock()
+	previous := node.Status(m.Current().Status)
+	res, err := m.client.RescueMode(m.SystemID())
+	if err == nil {
+		err = m.appendBytes(res)
+	}
+	m.mutex.Unlock()
+	if err != nil {
+		return err
+	}
+
+	status, err := m.WaitWhile(timeout, previous, node.StatusEnteringRescureMode)
+	if err == nil && status != node.StatusRescueMode {
+		err = fmt.Errorf("machine %s failed to enter rescue mode (status %d)", m.SystemID(), status)
+	}
+	return err
+}
+
+// ExitRescueMode calls the exit_rescue_mode operation on the API and waits up
+// to timeout for the machine to return to the status it had before rescue mode.
+func (m *MachineManager) ExitRescueMode(timeout time.Duration) error {
+	m.mutex.Lock()
+	res, err := m.client.ExitRescueMode(m.SystemID())
+	if err == nil {
+		err = m.appendBytes(res)
+	}
+	m.mutex.Unlock()
+	if err != nil {
+		return err
+	}
+
+	status, err := m.WaitWhile(timeout, node.StatusRescueMode, node.StatusExitingRescueMode)
+	if err == nil && status == node.StatusFailedExitingRescueMode {
+		err = fmt.Errorf("machine %s failed to exit rescue mode", m.SystemID())
+	}
+	return err
+}
+
+// MachinePollInterval is how often WaitWhile fetches the state of the machine.
+var MachinePollInterval = 10 * time.Second // nolint: gomnd
+
+// WaitWhile fetches the state of the machine until its status is not one of
+// pending, and returns that status. It returns an error if the state cannot be
+// fetched, or if the machine is still pending when the timeout elapses.
+func (m *MachineManager) WaitWhile(timeout time.Duration, pending ...node.Status) (node.Status, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		ma, err := m.Update()
+		if err != nil {
+			return node.Status(m.Current().Status), err
+		}
+		status := node.Status(ma.Status)
+		if !statusIn(status, pending) {
+			return status, nil
+		}
+		if time.Now().After(deadline) {
+			return status, fmt.Errorf("timed out waiting for machine %s (status %d)", m.SystemID(), status)
+		}
+		time.Sleep(MachinePollInterval)
+	}
+}
+
+func statusIn(status node.Status, statuses []node.Status) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
 // Update fetches and returns the current state of the machine.
 func (m *MachineManager) Update() (ma *Machine, err error) {
 	ma, err = m.update()
@@ -172,6 +384,17 @@ type MachineFetcher interface {
 	Commission(string, MachineCommissionParams) ([]byte, error)
 	Deploy(string, *MachineDeployParams) ([]byte, error)
 	Lock(string, string) ([]byte, error)
+	Unlock(string, string) ([]byte, error)
+	Put(string, MachineUpdateParams) ([]byte, error)
+	SetOwnerData(string, map[string]string) ([]byte, error)
+	PowerOn(string, MachinePowerOnParams) ([]byte, error)
+	PowerOff(string, MachinePowerOffParams) ([]byte, error)
+	QueryPowerState(string) ([]byte, error)
+	GetPowerParameters(string) ([]byte, error)
+	SetPowerParameters(string, MachinePowerParams) ([]byte, error)
+	RescueMode(string) ([]byte, error)
+	ExitRescueMode(string) ([]byte, error)
+	Delete(string) error
 }
 
 // MachineCommissionParams enumerates the parameters for the commission operation
@@ -184,16 +407,63 @@ type MachineCommissionParams struct {
 	TestingScripts       string
 }
 
-// MachineDeployParams enumerates the parameters for the deploy operation
+// MachineDeployParams enumerates the parameters for the deploy operation.
+// UserData is base64 encoded. OSystem and DistroSeries select the image to deploy:
+// custom images are deployed with the "custom" OSystem and their name as the DistroSeries.
+// LicenseKey is only needed for an OSystem that requires one (eg windows) and has no
+// license key configured in MaaS. KernelOptions are added to the kernel command line of
+// the deployed machine. EphemeralDeploy deploys the machine to RAM instead of its disks,
+// and EnableHWSync keeps the hardware of the machine in sync with MaaS after the deploy;
+// both need MaaS 3.2 or later. Empty fields are left to the MaaS defaults.
 type MachineDeployParams struct {
-	UserData     string
-	DistroSeries string
-	HWEKernel    string
-	AgentName    string
+	UserData        string
+	OSystem         string
+	DistroSeries    string
+	HWEKernel       string
+	LicenseKey      string
+	KernelOptions   string
+	AgentName       string
+	Comment         string
+	BridgeFD        int
+	BridgeAll       bool
+	BridgeSTP       bool
+	InstallRackD    bool
+	InstallKVM      bool
+	EphemeralDeploy bool
+	EnableHWSync    bool
+}
+
+// MachineUpdateParams enumerates the parameters for the PUT verb.
+// Nil fields are left unchanged, and fields set to an empty string are cleared.
+// The Comment is only recorded in the event log of the machine.
+type MachineUpdateParams struct {
+	Hostname     *string
+	Domain       *string
+	Description  *string
+	Zone         *string
+	Pool         *string
+	MinHWEKernel *string
 	Comment      string
-	BridgeFD     int
-	BridgeAll    bool
-	BridgeSTP    bool
-	InstallRackD bool `json:"install_rackd"`
-	InstallKVM   bool `json:"install_kvm"`
+}
+
+// MachinePowerOnParams enumerates the parameters for the power_on operation
+type MachinePowerOnParams struct {
+	UserData string
+	Comment  string
+}
+
+// MachinePowerOffParams enumerates the parameters for the power_off operation.
+// StopMode is either "soft" or "hard", and defaults to "hard" if empty.
+type MachinePowerOffParams struct {
+	StopMode string `json:"stop_mode"`
+	Comment  string
+}
+
+// MachinePowerParams enumerates the power settings of a machine.
+// Each of the Parameters is set as power_parameters_<key>. If SkipCheck is
+// set, the parameters are not validated against the PowerType.
+type MachinePowerParams struct {
+	Parameters map[string]string
+	PowerType  string
+	SkipCheck  bool
 }
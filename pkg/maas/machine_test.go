@@ -0,0 +1,138 @@
+package maas_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	. "github.com/roblox/terraform-provider-maas/pkg/maas"
+	"github.com/roblox/terraform-provider-maas/pkg/maas/entity/node"
+)
+
+// fakeMachineFetcher returns a machine with the next of its statuses each time
+// the machine is fetched. Operations return the current status.
+// The embedded interface panics when an unimplemented method is called.
+type fakeMachineFetcher struct {
+	MachineFetcher
+	statuses []node.Status
+	calls    int
+}
+
+func (f *fakeMachineFetcher) machine() []byte {
+	idx := f.calls
+	if idx >= len(f.statuses) {
+		idx = len(f.statuses) - 1
+	}
+	return []byte(fmt.Sprintf(`{"system_id": "abc123", "status": %d}`, f.statuses[idx]))
+}
+
+func (f *fakeMachineFetcher) Get(string) ([]byte, error) {
+	res := f.machine()
+	f.calls++
+	return res, nil
+}
+
+func (f *fakeMachineFetcher) RescueMode(string) ([]byte, error) {
+	return f.machine(), nil
+}
+
+func (f *fakeMachineFetcher) ExitRescueMode(string) ([]byte, error) {
+	return f.machine(), nil
+}
+
+func TestMachineManager_WaitWhile(t *testing.T) {
+	MachinePollInterval = time.Millisecond
+
+	tests := []struct {
+		name     string
+		statuses []node.Status
+		pending  []node.Status
+		timeout  time.Duration
+		want     node.Status
+		wantErr  bool
+	}{
+		{name: "not pending", statuses: []node.Status{node.StatusDeployed},
+			pending: []node.Status{node.StatusDeploying}, timeout: time.Second, want: node.StatusDeployed},
+		{name: "pending", statuses: []node.Status{node.StatusDeploying, node.StatusDeploying, node.StatusDeployed},
+			pending: []node.Status{node.StatusDeploying}, timeout: time.Second, want: node.StatusDeployed},
+		{name: "failed", statuses: []node.Status{node.StatusDeploying, node.StatusFailedDeployment},
+			pending: []node.Status{node.StatusDeploying}, timeout: time.Second, want: node.StatusFailedDeployment},
+		{name: "timeout", statuses: []node.Status{node.StatusDeploying},
+			pending: []node.Status{node.StatusDeploying}, timeout: 0, want: node.StatusDeploying, wantErr: true},
+	}
+
+	for _, testCase := range tests {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			manager, err := NewMachineManager("abc123", &fakeMachineFetcher{statuses: tc.statuses})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := manager.WaitWhile(tc.timeout, tc.pending...)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("WaitWhile() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func TestMachineManager_RescueMode(t *testing.T) {
+	MachinePollInterval = time.Millisecond
+
+	tests := []struct {
+		name     string
+		statuses []node.Status
+		wantErr  bool
+	}{
+		{name: "entered", statuses: []node.Status{node.StatusDeployed, node.StatusEnteringRescureMode,
+			node.StatusRescueMode}},
+		{name: "failed", statuses: []node.Status{node.StatusDeployed, node.StatusEnteringRescureMode,
+			node.StatusFailedEnteringRescueMode}, wantErr: true},
+	}
+
+	for _, testCase := range tests {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			manager, err := NewMachineManager("abc123", &fakeMachineFetcher{statuses: tc.statuses})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := manager.RescueMode(time.Second); (err != nil) != tc.wantErr {
+				t.Fatalf("RescueMode() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestMachineManager_ExitRescueMode(t *testing.T) {
+	MachinePollInterval = time.Millisecond
+
+	tests := []struct {
+		name     string
+		statuses []node.Status
+		wantErr  bool
+	}{
+		{name: "exited", statuses: []node.Status{node.StatusRescueMode, node.StatusExitingRescueMode,
+			node.StatusDeployed}},
+		{name: "failed", statuses: []node.Status{node.StatusRescueMode, node.StatusExitingRescueMode,
+			node.StatusFailedExitingRescueMode}, wantErr: true},
+	}
+
+	for _, testCase := range tests {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			manager, err := NewMachineManager("abc123", &fakeMachineFetcher{statuses: tc.statuses})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := manager.ExitRescueMode(time.Second); (err != nil) != tc.wantErr {
+				t.Fatalf("ExitRescueMode() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
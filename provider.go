@@ -30,18 +30,52 @@ func Provider() terraform.ResourceProvider {
 				Default:     "2.0",
 				Description: "The MAAS API version. Currently: 1.0",
 			},
+			"failover_api_urls": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Additional MAAS server URLs to use when api_url does not answer",
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"maas_instance":           resourceMAASInstance(),
-			"maas_interface_physical": provider.ResourceNetworkInterfacePhysical(),
-			"maas_interface_link":     provider.ResourceNetworkInterfaceLink(),
-			"maas_server":             provider.ResourceServer(),
+			"maas_instance":                   resourceMAASInstance(),
+			"maas_interface_physical":         provider.ResourceNetworkInterfacePhysical(),
+			"maas_interface_link":             provider.ResourceNetworkInterfaceLink(),
+			"maas_server":                     provider.ResourceServer(),
+			"maas_config_setting":             provider.ResourceConfigSetting(),
+			"maas_machine_power":              provider.ResourceMachinePower(),
+			"maas_tag":                        provider.ResourceTag(),
+			"maas_tag_machines":               provider.ResourceTagMachines(),
+			"maas_zone":                       provider.ResourceZone(),
+			"maas_resource_pool":              provider.ResourceResourcePool(),
+			"maas_dns_domain":                 provider.ResourceDNSDomain(),
+			"maas_dns_record":                 provider.ResourceDNSRecord(),
+			"maas_vm_host":                    provider.ResourceVMHost(),
+			"maas_vm":                         provider.ResourceVM(),
+			"maas_device":                     provider.ResourceDevice(),
+			"maas_user":                       provider.ResourceUser(),
+			"maas_ssh_key":                    provider.ResourceSSHKey(),
+			"maas_ssl_key":                    provider.ResourceSSLKey(),
+			"maas_dhcp_snippet":               provider.ResourceDHCPSnippet(),
+			"maas_package_repository":         provider.ResourcePackageRepository(),
+			"maas_node_script":                provider.ResourceNodeScript(),
+			"maas_license_key":                provider.ResourceLicenseKey(),
+			"maas_boot_source":                provider.ResourceBootSource(),
+			"maas_boot_source_selection":      provider.ResourceBootSourceSelection(),
+			"maas_rack_controller_image_sync": provider.ResourceRackControllerImageSync(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"maas_subnet":          provider.DataSubnet(),
-			"maas_rack_controller": provider.DataRackController(),
+			"maas_subnet":             provider.DataSubnet(),
+			"maas_rack_controller":    provider.DataRackController(),
+			"maas_rack_controllers":   provider.DataRackControllers(),
+			"maas_region_controllers": provider.DataRegionControllers(),
+			"maas_machine_results":    provider.DataMachineResults(),
+			"maas_zone":               provider.DataZone(),
+			"maas_resource_pool":      provider.DataResourcePool(),
+			"maas_vm_hosts":           provider.DataVMHosts(),
+			"maas_boot_images":        provider.DataBootImages(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -56,5 +90,8 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		APIURL: d.Get("api_url").(string),
 		APIver: d.Get("api_version").(string),
 	}
+	for _, u := range d.Get("failover_api_urls").([]interface{}) {
+		config.FailoverURLs = append(config.FailoverURLs, u.(string))
+	}
 	return config.Client()
 }
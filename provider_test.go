@@ -1,10 +1,13 @@
 package main
 
 import (
+	"net/http"
 	"testing"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/jarcoal/httpmock"
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
@@ -31,3 +34,35 @@ func TestProvider_impl(t *testing.T) {
 	}()
 	var _ terraform.ResourceProvider = Provider()
 }
+
+// The resources of the internal provider package are served with the Config of this package as meta
+func TestProvider_InternalResource(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	zone := `{"id": 2, "name": "zone-north", "description": "North", "resource_uri": "/MAAS/api/2.0/zones/zone-north/"}`
+	httpmock.RegisterResponder("POST", "http://localhost:5240/MAAS/api/2.0/zones/",
+		httpmock.NewStringResponder(http.StatusOK, zone))
+	httpmock.RegisterResponder("GET", "http://localhost:5240/MAAS/api/2.0/zones/zone-north/",
+		httpmock.NewStringResponder(http.StatusOK, zone))
+
+	p := Provider().(*schema.Provider)
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"api_url": "http://localhost:5240/MAAS",
+		"api_key": "some:secret:key",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Configure(terraform.NewResourceConfig(raw)); err != nil {
+		t.Fatal(err)
+	}
+
+	res := p.ResourcesMap["maas_zone"]
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"name": "zone-north", "description": "North"})
+	if err := res.Create(d, p.Meta()); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "zone-north" {
+		t.Errorf("ID = %q, want zone-north", d.Id())
+	}
+}
@@ -1,7 +1,7 @@
 package main
 
 import (
-	"encoding/base64"
+	"strings"
 )
 
 // func userDataHashSum(user_data string) string {
@@ -14,6 +14,26 @@ import (
 // 	return hex.EncodeToString(hash[:])
 // }
 
-func base64encode(data string) string {
-	return base64.StdEncoding.EncodeToString([]byte(data))
+// tailLines returns the last n lines of data, without any trailing newline
+func tailLines(data string, n int) string {
+	lines := strings.Split(strings.TrimRight(data, "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// listDifference returns the strings in a that are not in b
+func listDifference(a, b []interface{}) []string {
+	exclude := make(map[string]bool, len(b))
+	for _, val := range b {
+		exclude[val.(string)] = true
+	}
+	var res []string
+	for _, val := range a {
+		if !exclude[val.(string)] {
+			res = append(res, val.(string))
+		}
+	}
+	return res
 }
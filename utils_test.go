@@ -0,0 +1,54 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestTailLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		n     int
+		want  string
+	}{
+		{name: "empty", input: "", n: 3, want: ""},
+		{name: "short", input: "a\nb\n", n: 3, want: "a\nb"},
+		{name: "exact", input: "a\nb\nc", n: 3, want: "a\nb\nc"},
+		{name: "long", input: "a\nb\nc\nd\ne\n", n: 3, want: "c\nd\ne"},
+	}
+
+	for _, testCase := range tests {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tailLines(tc.input, tc.n); got != tc.want {
+				t.Fatalf("tailLines() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestListDifference(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []interface{}
+		b    []interface{}
+		want []string
+	}{
+		{name: "empty", a: []interface{}{}, b: []interface{}{"x"}, want: nil},
+		{name: "added", a: []interface{}{"x", "y"}, b: []interface{}{"x"}, want: []string{"y"}},
+		{name: "same", a: []interface{}{"x", "y"}, b: []interface{}{"y", "x"}, want: nil},
+		{name: "disjoint", a: []interface{}{"x", "y"}, b: []interface{}{}, want: []string{"x", "y"}},
+	}
+
+	for _, testCase := range tests {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, listDifference(tc.a, tc.b), cmpopts.EquateEmpty()); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}